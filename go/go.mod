@@ -1,6 +1,6 @@
 module github.com/dhruvmanila/advent-of-code/go
 
-go 1.18
+go 1.23
 
 require (
 	github.com/MakeNowJust/heredoc v1.0.0
@@ -0,0 +1,150 @@
+// Package ansi implements the ANSI escape sequences to style the text and to
+// control the cursor in a terminal, along with a renderer to animate frames
+// in place.
+package ansi
+
+import (
+	"fmt"
+	"strings"
+)
+
+// csi is the Control Sequence Introducer which starts every escape sequence
+// in this package.
+const csi = "\033["
+
+// Attr is a Select Graphic Rendition (SGR) parameter which sets a style or a
+// color of the text.
+type Attr string
+
+// Text styles.
+const (
+	Reset     Attr = "0"
+	Bold      Attr = "1"
+	Dim       Attr = "2"
+	Italic    Attr = "3"
+	Underline Attr = "4"
+	Blink     Attr = "5"
+	Reverse   Attr = "7"
+	Hidden    Attr = "8"
+	Strike    Attr = "9"
+)
+
+// Foreground colors.
+const (
+	Black   Attr = "30"
+	Red     Attr = "31"
+	Green   Attr = "32"
+	Yellow  Attr = "33"
+	Blue    Attr = "34"
+	Magenta Attr = "35"
+	Cyan    Attr = "36"
+	White   Attr = "37"
+
+	BrightBlack   Attr = "90"
+	BrightRed     Attr = "91"
+	BrightGreen   Attr = "92"
+	BrightYellow  Attr = "93"
+	BrightBlue    Attr = "94"
+	BrightMagenta Attr = "95"
+	BrightCyan    Attr = "96"
+	BrightWhite   Attr = "97"
+)
+
+// Background colors.
+const (
+	BgBlack   Attr = "40"
+	BgRed     Attr = "41"
+	BgGreen   Attr = "42"
+	BgYellow  Attr = "43"
+	BgBlue    Attr = "44"
+	BgMagenta Attr = "45"
+	BgCyan    Attr = "46"
+	BgWhite   Attr = "47"
+)
+
+// Color256 returns the foreground color n from the 256 color palette.
+func Color256(n uint8) Attr {
+	return Attr(fmt.Sprintf("38;5;%d", n))
+}
+
+// BgColor256 returns the background color n from the 256 color palette.
+func BgColor256(n uint8) Attr {
+	return Attr(fmt.Sprintf("48;5;%d", n))
+}
+
+// RGB returns the 24-bit foreground color with the given components.
+func RGB(r, g, b uint8) Attr {
+	return Attr(fmt.Sprintf("38;2;%d;%d;%d", r, g, b))
+}
+
+// BgRGB returns the 24-bit background color with the given components.
+func BgRGB(r, g, b uint8) Attr {
+	return Attr(fmt.Sprintf("48;2;%d;%d;%d", r, g, b))
+}
+
+// SGR returns the escape sequence which sets all the given attributes.
+func SGR(attrs ...Attr) string {
+	params := make([]string, len(attrs))
+	for i, a := range attrs {
+		params[i] = string(a)
+	}
+	return csi + strings.Join(params, ";") + "m"
+}
+
+// Style returns the string s styled with all the given attributes followed
+// by a reset of the style. The string is returned as is if there are no
+// attributes.
+func Style(s string, attrs ...Attr) string {
+	if len(attrs) == 0 {
+		return s
+	}
+	return SGR(attrs...) + s + SGR(Reset)
+}
+
+// Cursor and screen control sequences.
+const (
+	HideCursor  = csi + "?25l"
+	ShowCursor  = csi + "?25h"
+	ClearScreen = csi + "2J" + csi + "H"
+	ClearLine   = csi + "2K"
+	SaveCursor  = "\0337"
+	LoadCursor  = "\0338"
+)
+
+// CursorUp returns the escape sequence which moves the cursor up n lines.
+func CursorUp(n int) string {
+	return cursorMove(n, 'A')
+}
+
+// CursorDown returns the escape sequence which moves the cursor down n lines.
+func CursorDown(n int) string {
+	return cursorMove(n, 'B')
+}
+
+// CursorForward returns the escape sequence which moves the cursor forward,
+// or right, by n columns.
+func CursorForward(n int) string {
+	return cursorMove(n, 'C')
+}
+
+// CursorBack returns the escape sequence which moves the cursor back, or
+// left, by n columns.
+func CursorBack(n int) string {
+	return cursorMove(n, 'D')
+}
+
+// MoveTo returns the escape sequence which moves the cursor to column x and
+// row y, where the top-left corner of the screen is at (0, 0).
+func MoveTo(x, y int) string {
+	return fmt.Sprintf("%s%d;%dH", csi, y+1, x+1)
+}
+
+// cursorMove returns the escape sequence which moves the cursor n times in
+// the direction given by the final byte. Terminals treat a count of 0 as 1,
+// so nothing is returned for it.
+func cursorMove(n int, final byte) string {
+	if n <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s%d%c", csi, n, final)
+}
@@ -0,0 +1,93 @@
+package ansi
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStyle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		attrs    []Attr
+		expected string
+	}{
+		{name: "plain", attrs: nil, expected: "x"},
+		{name: "single", attrs: []Attr{Reverse}, expected: "\033[7mx\033[0m"},
+		{name: "multiple", attrs: []Attr{Bold, Red, BgBlue}, expected: "\033[1;31;44mx\033[0m"},
+		{name: "palette", attrs: []Attr{Color256(208), BgColor256(0)}, expected: "\033[38;5;208;48;5;0mx\033[0m"},
+		{name: "rgb", attrs: []Attr{RGB(1, 2, 3), BgRGB(4, 5, 6)}, expected: "\033[38;2;1;2;3;48;2;4;5;6mx\033[0m"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := Style("x", tc.attrs...); actual != tc.expected {
+				t.Errorf("\nexpected: %q\nactual: %q\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+	testCases := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{name: "up", actual: CursorUp(3), expected: "\033[3A"},
+		{name: "down", actual: CursorDown(1), expected: "\033[1B"},
+		{name: "forward", actual: CursorForward(2), expected: "\033[2C"},
+		{name: "back", actual: CursorBack(4), expected: "\033[4D"},
+		{name: "zero", actual: CursorUp(0), expected: ""},
+		{name: "move", actual: MoveTo(0, 4), expected: "\033[5;1H"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.actual != tc.expected {
+				t.Errorf("\nexpected: %q\nactual: %q\n", tc.expected, tc.actual)
+			}
+		})
+	}
+}
+
+func TestRenderer(t *testing.T) {
+	var sb strings.Builder
+	r := NewRenderer(&sb)
+
+	steps := []struct {
+		frame    string
+		expected string
+	}{
+		{
+			frame:    "ab\ncd\n",
+			expected: HideCursor + "\r" + ClearLine + "ab\n\r" + ClearLine + "cd\n",
+		},
+		{
+			frame:    "ab\nxy",
+			expected: CursorUp(2) + "\n\r" + ClearLine + "xy\n",
+		},
+		{
+			frame:    "ab",
+			expected: CursorUp(2) + "\n\r" + ClearLine + "\n",
+		},
+		{
+			frame:    "zz",
+			expected: CursorUp(2) + "\r" + ClearLine + "zz\n",
+		},
+	}
+
+	for i, step := range steps {
+		sb.Reset()
+		if err := r.Render(step.frame); err != nil {
+			t.Fatal(err)
+		}
+		if actual := sb.String(); actual != step.expected {
+			t.Errorf("frame %d\nexpected: %q\nactual: %q\n", i, step.expected, actual)
+		}
+	}
+
+	sb.Reset()
+	if err := r.Close(); err != nil || sb.String() != ShowCursor {
+		t.Errorf("r.Close()\nexpected: %q\nactual: %q (%v)\n", ShowCursor, sb.String(), err)
+	}
+}
@@ -0,0 +1,66 @@
+package ansi
+
+import (
+	"io"
+	"strings"
+)
+
+// Renderer draws a sequence of frames in place on a terminal, which is useful
+// to animate a simulation. It keeps the previous frame as the front buffer,
+// so only the lines which changed since then are written again, avoiding the
+// flicker of clearing the whole screen for every frame.
+//
+// The frames are drawn starting from the cursor position when the first
+// frame is rendered, and every frame is assumed to fit in the terminal.
+type Renderer struct {
+	w     io.Writer
+	front []string
+	// height is the number of lines the cursor is below the top of the frame.
+	height  int
+	started bool
+}
+
+// NewRenderer returns a new renderer which writes the frames to w.
+func NewRenderer(w io.Writer) *Renderer {
+	return &Renderer{w: w}
+}
+
+// Render draws the given frame over the previous one, where the lines of the
+// frame are separated by a newline. The cursor is hidden until Close.
+func (r *Renderer) Render(frame string) error {
+	back := strings.Split(strings.TrimSuffix(frame, "\n"), "\n")
+
+	var sb strings.Builder
+	if !r.started {
+		sb.WriteString(HideCursor)
+		r.started = true
+	}
+	sb.WriteString(CursorUp(r.height))
+	for i := 0; i < max(len(back), len(r.front)); i++ {
+		switch {
+		case i < len(back) && i < len(r.front) && back[i] == r.front[i]:
+			// The line is unchanged, so the cursor only moves past it.
+		case i < len(back):
+			sb.WriteString("\r" + ClearLine + back[i])
+		default:
+			// The previous frame was taller, so clear the leftover lines.
+			sb.WriteString("\r" + ClearLine)
+		}
+		sb.WriteByte('\n')
+	}
+	r.height = max(len(back), len(r.front))
+	r.front = back
+
+	_, err := io.WriteString(r.w, sb.String())
+	return err
+}
+
+// Close shows the cursor again if any frame was rendered. The cursor is left
+// on the line after the last frame.
+func (r *Renderer) Close() error {
+	if !r.started {
+		return nil
+	}
+	_, err := io.WriteString(r.w, ShowCursor)
+	return err
+}
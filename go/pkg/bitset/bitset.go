@@ -0,0 +1,223 @@
+// Package bitset implements a set of non-negative integers using a bit
+// vector of an arbitrary length.
+//
+// This is much more memory efficient than set.Set[int] when the integers are
+// dense and small, like the indices of the nodes in a graph.
+package bitset
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"strings"
+)
+
+const wordSize = 64
+
+// BitSet is a set of non-negative integers. It grows as needed when an
+// integer is added to the set.
+//
+// The zero value is an empty set ready to use.
+type BitSet struct {
+	words []uint64
+}
+
+// New returns an initialized empty bitset with the capacity for the integers
+// less than n without growing.
+func New(n int) *BitSet {
+	return &BitSet{words: make([]uint64, 0, (n+wordSize-1)/wordSize)}
+}
+
+// FromInts returns a new bitset containing all the given integers.
+func FromInts(is ...int) *BitSet {
+	b := new(BitSet)
+	for _, i := range is {
+		b.Set(i)
+	}
+	return b
+}
+
+// Set adds the integer i to the set. It panics if i is negative.
+func (b *BitSet) Set(i int) {
+	w := index(i)
+	if w >= len(b.words) {
+		b.words = append(b.words, make([]uint64, w-len(b.words)+1)...)
+	}
+	b.words[w] |= 1 << (uint(i) % wordSize)
+}
+
+// Clear removes the integer i from the set. It panics if i is negative.
+func (b *BitSet) Clear(i int) {
+	if w := index(i); w < len(b.words) {
+		b.words[w] &^= 1 << (uint(i) % wordSize)
+	}
+}
+
+// Flip adds the integer i to the set if it's not present, otherwise removes
+// it. It panics if i is negative.
+func (b *BitSet) Flip(i int) {
+	if b.Test(i) {
+		b.Clear(i)
+	} else {
+		b.Set(i)
+	}
+}
+
+// Test returns true if the integer i is in the set. It panics if i is
+// negative.
+func (b *BitSet) Test(i int) bool {
+	w := index(i)
+	return w < len(b.words) && b.words[w]&(1<<(uint(i)%wordSize)) != 0
+}
+
+// PopCount returns the number of integers in the set.
+func (b *BitSet) PopCount() int {
+	count := 0
+	for _, w := range b.words {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// IsEmpty is used to check whether the set is empty or not.
+func (b *BitSet) IsEmpty() bool {
+	for _, w := range b.words {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// NextSet returns the smallest integer in the set which is greater than or
+// equal to i. It returns false if there's no such integer.
+//
+// This can be used to iterate over the integers in the set:
+//
+//	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+//		...
+//	}
+func (b *BitSet) NextSet(i int) (int, bool) {
+	if i < 0 {
+		i = 0
+	}
+	w := i / wordSize
+	if w >= len(b.words) {
+		return 0, false
+	}
+	// Mask out the bits lower than i in the first word.
+	word := b.words[w] >> (uint(i) % wordSize)
+	if word != 0 {
+		return i + bits.TrailingZeros64(word), true
+	}
+	for w++; w < len(b.words); w++ {
+		if b.words[w] != 0 {
+			return w*wordSize + bits.TrailingZeros64(b.words[w]), true
+		}
+	}
+	return 0, false
+}
+
+// All returns an iterator over the integers in the set in increasing order.
+func (b *BitSet) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// And returns a new set containing the integers which are in both b and other.
+func (b *BitSet) And(other *BitSet) *BitSet {
+	words := make([]uint64, min(len(b.words), len(other.words)))
+	for i := range words {
+		words[i] = b.words[i] & other.words[i]
+	}
+	return &BitSet{words: words}
+}
+
+// Or returns a new set containing the integers which are in either b or
+// other.
+func (b *BitSet) Or(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x | y })
+}
+
+// Xor returns a new set containing the integers which are in exactly one of
+// b and other.
+func (b *BitSet) Xor(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x ^ y })
+}
+
+// AndNot returns a new set containing the integers which are in b but not in
+// other.
+func (b *BitSet) AndNot(other *BitSet) *BitSet {
+	return b.combine(other, func(x, y uint64) uint64 { return x &^ y })
+}
+
+// IsSubset returns true if every integer in b is also in other.
+func (b *BitSet) IsSubset(other *BitSet) bool {
+	for i, w := range b.words {
+		var o uint64
+		if i < len(other.words) {
+			o = other.words[i]
+		}
+		if w&^o != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if b and other contain the same integers, irrespective
+// of their capacity.
+func (b *BitSet) Equal(other *BitSet) bool {
+	return b.IsSubset(other) && other.IsSubset(b)
+}
+
+// Clone returns a copy of the set.
+func (b *BitSet) Clone() *BitSet {
+	return &BitSet{words: append([]uint64(nil), b.words...)}
+}
+
+// String returns the integers in the set in increasing order, like "{1 4 9}".
+// As a bitset cannot be used as a map key, this can be used instead.
+func (b *BitSet) String() string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i := range b.All() {
+		if sb.Len() > 1 {
+			sb.WriteByte(' ')
+		}
+		fmt.Fprint(&sb, i)
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// combine returns a new set where every word is the result of calling fn with
+// the words of b and other at the same position, treating the missing words
+// as zero.
+func (b *BitSet) combine(other *BitSet, fn func(x, y uint64) uint64) *BitSet {
+	words := make([]uint64, max(len(b.words), len(other.words)))
+	for i := range words {
+		var x, y uint64
+		if i < len(b.words) {
+			x = b.words[i]
+		}
+		if i < len(other.words) {
+			y = other.words[i]
+		}
+		words[i] = fn(x, y)
+	}
+	return &BitSet{words: words}
+}
+
+// index returns the index of the word containing the bit i.
+func index(i int) int {
+	if i < 0 {
+		panic(fmt.Sprintf("bitset: negative index %d", i))
+	}
+	return i / wordSize
+}
@@ -0,0 +1,72 @@
+package bitset
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestBitSet(t *testing.T) {
+	var b BitSet
+	for _, i := range []int{3, 64, 200, 0} {
+		b.Set(i)
+	}
+	b.Clear(64)
+	b.Clear(1000)
+	b.Flip(5)
+	b.Flip(0)
+
+	if expected, actual := []int{3, 5, 200}, slices.Collect(b.All()); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if count := b.PopCount(); count != 3 {
+		t.Errorf("b.PopCount(); expected: 3, actual: %d\n", count)
+	}
+	if !b.Test(200) || b.Test(64) || b.Test(100000) {
+		t.Errorf("b.Test(); unexpected membership for %v\n", &b)
+	}
+	if i, ok := b.NextSet(6); !ok || i != 200 {
+		t.Errorf("b.NextSet(6); expected: 200, actual: %d\n", i)
+	}
+	if _, ok := b.NextSet(201); ok {
+		t.Error("b.NextSet(201); expected: false")
+	}
+	if s := b.String(); s != "{3 5 200}" {
+		t.Errorf("b.String(); expected: {3 5 200}, actual: %s\n", s)
+	}
+}
+
+func TestBitSetOperations(t *testing.T) {
+	a, b := FromInts(1, 2, 70, 130), FromInts(2, 3, 70)
+
+	testCases := []struct {
+		name     string
+		actual   *BitSet
+		expected *BitSet
+	}{
+		{name: "and", actual: a.And(b), expected: FromInts(2, 70)},
+		{name: "or", actual: a.Or(b), expected: FromInts(1, 2, 3, 70, 130)},
+		{name: "xor", actual: a.Xor(b), expected: FromInts(1, 3, 130)},
+		{name: "and not", actual: a.AndNot(b), expected: FromInts(1, 130)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !tc.actual.Equal(tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, tc.actual)
+			}
+		})
+	}
+
+	if !FromInts(2, 70).IsSubset(a) || b.IsSubset(a) {
+		t.Error("IsSubset; unexpected result")
+	}
+	c := a.Clone()
+	c.Set(5)
+	if a.Test(5) {
+		t.Error("a.Clone(); expected clone to be independent")
+	}
+	if !New(10).IsEmpty() || !a.And(FromInts(500)).IsEmpty() {
+		t.Error("IsEmpty; expected empty set")
+	}
+}
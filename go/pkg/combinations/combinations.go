@@ -1,5 +1,12 @@
+// Package combinations implements the generation of combinations, subsets and
+// permutations for slices of any element type.
 package combinations
 
+import (
+	"iter"
+	"slices"
+)
+
 // All returns all combinations for a given generic slice.
 //
 // This is essentially a powerset of the given set except that the empty set is
@@ -24,3 +31,129 @@ func All[T any](set []T) [][]T {
 	}
 	return subsets
 }
+
+// PowerSet returns an iterator over all the subsets of the given slice,
+// including the empty one. The subsets are yielded in the same order as All,
+// with the empty subset first.
+//
+// Every subset is a newly allocated slice, so it's safe to retain it.
+func PowerSet[T any](set []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		length := uint(len(set))
+		for subsetBits := 0; subsetBits < 1<<length; subsetBits++ {
+			subset := []T{}
+			for object := uint(0); object < length; object++ {
+				if (subsetBits>>object)&1 == 1 {
+					subset = append(subset, set[object])
+				}
+			}
+			if !yield(subset) {
+				return
+			}
+		}
+	}
+}
+
+// K returns an iterator over all the k-element subsets of the indices from 0
+// to n-1, in lexicographic order. Each subset is sorted in increasing order.
+// Nothing is yielded if k is negative or greater than n.
+//
+// Every subset is a newly allocated slice, so it's safe to retain it.
+func K(n, k int) iter.Seq[[]int] {
+	return func(yield func([]int) bool) {
+		if k < 0 || k > n {
+			return
+		}
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+		for {
+			if !yield(slices.Clone(indices)) {
+				return
+			}
+			// Find the rightmost index which can still be incremented, which
+			// is the one not yet at its maximum value of n-k+i.
+			i := k - 1
+			for i >= 0 && indices[i] == n-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}
+}
+
+// Choose returns an iterator over all the k-element combinations of the given
+// slice, preserving the relative order of the elements. The combinations are
+// yielded in lexicographic order of their indices.
+//
+// Every combination is a newly allocated slice, so it's safe to retain it.
+func Choose[T any](set []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for indices := range K(len(set), k) {
+			combination := make([]T, k)
+			for i, idx := range indices {
+				combination[i] = set[idx]
+			}
+			if !yield(combination) {
+				return
+			}
+		}
+	}
+}
+
+// Permutations returns an iterator over all the permutations of the given
+// slice, in lexicographic order of the indices starting with the slice
+// itself. The elements are treated as distinct by their position, so
+// duplicate values yield duplicate permutations.
+//
+// Every permutation is a newly allocated slice, so it's safe to retain it.
+func Permutations[T any](set []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		indices := make([]int, len(set))
+		for i := range indices {
+			indices[i] = i
+		}
+		for {
+			permutation := make([]T, len(set))
+			for i, idx := range indices {
+				permutation[i] = set[idx]
+			}
+			if !yield(permutation) {
+				return
+			}
+			if !nextPermutation(indices) {
+				return
+			}
+		}
+	}
+}
+
+// nextPermutation rearranges the indices into the lexicographically next
+// permutation, returning false if they are already in the last one.
+func nextPermutation(indices []int) bool {
+	// Find the rightmost ascent, everything after which is in decreasing
+	// order and thus already the last permutation of its elements.
+	i := len(indices) - 2
+	for i >= 0 && indices[i] >= indices[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(indices) - 1
+	for indices[j] <= indices[i] {
+		j--
+	}
+	indices[i], indices[j] = indices[j], indices[i]
+	for l, r := i+1, len(indices)-1; l < r; l, r = l+1, r-1 {
+		indices[l], indices[r] = indices[r], indices[l]
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+package combinations
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestAll(t *testing.T) {
+	expected := [][]int{{1}, {2}, {1, 2}, {3}, {1, 3}, {2, 3}, {1, 2, 3}}
+	if actual := All([]int{1, 2, 3}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		set      []string
+		expected [][]string
+	}{
+		{name: "empty", set: nil, expected: [][]string{{}}},
+		{
+			name:     "three",
+			set:      []string{"a", "b", "c"},
+			expected: [][]string{{}, {"a"}, {"b"}, {"a", "b"}, {"c"}, {"a", "c"}, {"b", "c"}, {"a", "b", "c"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := slices.Collect(PowerSet(tc.set)); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestK(t *testing.T) {
+	testCases := []struct {
+		name     string
+		n, k     int
+		expected [][]int
+	}{
+		{name: "zero", n: 3, k: 0, expected: [][]int{{}}},
+		{name: "one", n: 3, k: 1, expected: [][]int{{0}, {1}, {2}}},
+		{name: "two", n: 4, k: 2, expected: [][]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}},
+		{name: "all", n: 3, k: 3, expected: [][]int{{0, 1, 2}}},
+		{name: "too many", n: 2, k: 3, expected: nil},
+		{name: "negative", n: 2, k: -1, expected: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := slices.Collect(K(tc.n, tc.k)); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestChoose(t *testing.T) {
+	expected := [][]string{{"a", "b"}, {"a", "c"}, {"b", "c"}}
+	if actual := slices.Collect(Choose([]string{"a", "b", "c"}, 2)); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	testCases := []struct {
+		name     string
+		set      []int
+		expected [][]int
+	}{
+		{name: "empty", set: nil, expected: [][]int{{}}},
+		{name: "one", set: []int{7}, expected: [][]int{{7}}},
+		{
+			name:     "three",
+			set:      []int{3, 1, 2},
+			expected: [][]int{{3, 1, 2}, {3, 2, 1}, {1, 3, 2}, {1, 2, 3}, {2, 3, 1}, {2, 1, 3}},
+		},
+		{
+			name:     "duplicates",
+			set:      []int{1, 1},
+			expected: [][]int{{1, 1}, {1, 1}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := slices.Collect(Permutations(tc.set)); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLazy(t *testing.T) {
+	count := 0
+	for range Permutations([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}) {
+		count++
+		if count == 5 {
+			break
+		}
+	}
+	if count != 5 {
+		t.Errorf("\nexpected: %v\nactual: %v\n", 5, count)
+	}
+}
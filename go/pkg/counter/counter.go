@@ -2,39 +2,78 @@
 package counter
 
 import (
+	"cmp"
 	"fmt"
+	"iter"
 	"math"
+	"slices"
 	"strings"
 )
 
 // Counter is a generic counter for counting items.
-type Counter[T comparable] map[T]int
+//
+// The total count of all the items is maintained incrementally as the counts
+// are updated, so the counter should only be modified through its methods.
+type Counter[T comparable] struct {
+	counts map[T]int
+	total  int
+}
+
+// Entry is a pair of an item and its count in the counter.
+type Entry[T comparable] struct {
+	Item  T
+	Count int
+}
 
 // New creates and returns a new counter, optionally with the given items.
-func New[T comparable](items ...T) Counter[T] {
+func New[T comparable](items ...T) *Counter[T] {
 	return NewFromSlice(items)
 }
 
 // NewFromMap creates and returns a new counter from an existing map.
 // This creates a copy of the given map which induces a runtime cost.
-func NewFromMap[T comparable](m map[T]int) Counter[T] {
-	mc := make(map[T]int, len(m))
+func NewFromMap[T comparable](m map[T]int) *Counter[T] {
+	c := newWithSize[T](len(m))
 	for item, count := range m {
-		mc[item] = count
+		c.counts[item] = count
+		c.total += count
 	}
-	return mc
+	return c
 }
 
 // NewFromSlice creates and returns a new counter from an existing slice.
-func NewFromSlice[T comparable](sl []T) Counter[T] {
-	c := make(Counter[T], len(sl))
+func NewFromSlice[T comparable](sl []T) *Counter[T] {
+	c := newWithSize[T](len(sl))
 	c.Increment(sl...)
 	return c
 }
 
+// Merge creates and returns a new counter with the counts from all the given
+// counters added together. This is equivalent to calling Update on an empty
+// counter with every counter, except that the result is allocated only once.
+func Merge[T comparable](cs ...*Counter[T]) *Counter[T] {
+	// The largest counter is a lower bound on the number of distinct items,
+	// while the sum of all the lengths is an upper bound.
+	size := 0
+	for _, c := range cs {
+		size = max(size, c.Len())
+	}
+	m := newWithSize[T](size)
+	for _, c := range cs {
+		m.Update(c)
+	}
+	return m
+}
+
+// newWithSize creates and returns a new empty counter with space for
+// approximately size items.
+func newWithSize[T comparable](size int) *Counter[T] {
+	return &Counter[T]{counts: make(map[T]int, size)}
+}
+
 // Increment increments the count of all the given items by 1, else initiating
 // the count to 1.
-func (c Counter[T]) Increment(items ...T) {
+func (c *Counter[T]) Increment(items ...T) {
 	for _, item := range items {
 		c.IncrementBy(item, 1)
 	}
@@ -42,18 +81,15 @@ func (c Counter[T]) Increment(items ...T) {
 
 // IncrementBy is used to add count for an item if it exists, else initiating the
 // item with given count.
-func (c Counter[T]) IncrementBy(item T, count int) {
-	if c.contains(item) {
-		c[item] += count
-	} else {
-		c[item] = count
-	}
+func (c *Counter[T]) IncrementBy(item T, count int) {
+	c.counts[item] += count
+	c.total += count
 }
 
 // Decrement decrements the count of all the given items by 1. This won't let
 // the count of an item be negative. Use counter.DecrementBy() to decrement the
 // count below 0.
-func (c Counter[T]) Decrement(items ...T) {
+func (c *Counter[T]) Decrement(items ...T) {
 	for _, item := range items {
 		if c.Get(item) > 0 {
 			c.DecrementBy(item, 1)
@@ -64,45 +100,134 @@ func (c Counter[T]) Decrement(items ...T) {
 // DecrementBy is used to subtract count for an item if it exists, else
 // initiating the item with given count. The count can be reduced to zero
 // or negative.
-func (c Counter[T]) DecrementBy(item T, count int) {
-	if c.contains(item) {
-		c[item] -= count
-	} else {
-		c[item] = -count
-	}
+func (c *Counter[T]) DecrementBy(item T, count int) {
+	c.counts[item] -= count
+	c.total -= count
 }
 
 // Delete deletes all the items from the counter completely. To decrement the
 // count of an item, use counter.Decrement() or counter.DecrementBy() instead.
-func (c Counter[T]) Delete(items ...T) {
+func (c *Counter[T]) Delete(items ...T) {
 	for _, item := range items {
-		delete(c, item)
+		c.total -= c.counts[item]
+		delete(c.counts, item)
 	}
 }
 
 // Update updates the current counter with the counts from the other counter.
-func (c Counter[T]) Update(other Counter[T]) {
-	for item, count := range other {
-		if c.contains(item) {
-			c[item] += count
-		} else {
-			c[item] = count
+func (c *Counter[T]) Update(other *Counter[T]) {
+	for item, count := range other.counts {
+		c.counts[item] += count
+	}
+	c.total += other.total
+}
+
+// Add returns a new counter with the counts of c and other added together.
+//
+// Like Python's collections.Counter, only the items with a positive count are
+// kept in the resulting counter.
+func (c *Counter[T]) Add(other *Counter[T]) *Counter[T] {
+	n := NewFromMap(c.counts)
+	n.Update(other)
+	n.KeepPositive()
+	return n
+}
+
+// Subtract returns a new counter with the counts of other subtracted from c.
+// Only the items with a positive count are kept in the resulting counter.
+func (c *Counter[T]) Subtract(other *Counter[T]) *Counter[T] {
+	n := NewFromMap(c.counts)
+	for item, count := range other.counts {
+		n.DecrementBy(item, count)
+	}
+	n.KeepPositive()
+	return n
+}
+
+// Union returns a new counter with the maximum of the counts of every item in
+// c and other. Only the items with a positive count are kept in the
+// resulting counter.
+func (c *Counter[T]) Union(other *Counter[T]) *Counter[T] {
+	n := NewFromMap(c.counts)
+	for item, count := range other.counts {
+		if current := n.Get(item); count > current {
+			n.IncrementBy(item, count-current)
 		}
 	}
+	n.KeepPositive()
+	return n
+}
+
+// Intersection returns a new counter with the minimum of the counts of every
+// item common to c and other. Only the items with a positive count are kept
+// in the resulting counter.
+func (c *Counter[T]) Intersection(other *Counter[T]) *Counter[T] {
+	n := New[T]()
+	// Loop over the smaller counter.
+	if c.Len() > other.Len() {
+		c, other = other, c
+	}
+	for item, count := range c.counts {
+		if other.contains(item) {
+			if m := min(count, other.counts[item]); m > 0 {
+				n.IncrementBy(item, m)
+			}
+		}
+	}
+	return n
+}
+
+// Scale multiplies the count of every item in the counter by factor.
+func (c *Counter[T]) Scale(factor int) {
+	for item := range c.counts {
+		c.counts[item] *= factor
+	}
+	c.total *= factor
 }
 
 // KeepPositive will delete negative and zero count items from the counter.
-func (c Counter[T]) KeepPositive() {
-	for item, count := range c {
-		if count <= 0 {
-			delete(c, item)
+func (c *Counter[T]) KeepPositive() {
+	c.Prune(1)
+}
+
+// Prune will delete all the items from the counter whose count is less than
+// minCount. KeepPositive is equivalent to Prune(1).
+func (c *Counter[T]) Prune(minCount int) {
+	for item, count := range c.counts {
+		if count < minCount {
+			c.total -= count
+			delete(c.counts, item)
+		}
+	}
+}
+
+// CountIf returns the number of items in the counter for which the given
+// predicate function returns true.
+func (c *Counter[T]) CountIf(pred func(item T, count int) bool) int {
+	n := 0
+	for item, count := range c.counts {
+		if pred(item, count) {
+			n++
 		}
 	}
+	return n
+}
+
+// KeysWithCountAtLeast returns all the items in the counter whose count is
+// greater than or equal to n. The order of the items is arbitrary.
+func (c *Counter[T]) KeysWithCountAtLeast(n int) []T {
+	var items []T
+	for item, count := range c.counts {
+		if count >= n {
+			items = append(items, item)
+		}
+	}
+	return items
 }
 
 // Get is used to get the count for an item, 0 if the item does not exists.
-func (c Counter[T]) Get(item T) int {
-	if count, exists := c[item]; exists {
+func (c *Counter[T]) Get(item T) int {
+	if count, exists := c.counts[item]; exists {
 		return count
 	}
 	return 0
@@ -110,10 +235,10 @@ func (c Counter[T]) Get(item T) int {
 
 // MostCommon is used to get the most common (highest count) item. Use
 // Counter.Get() to get the count of that item.
-func (c Counter[T]) MostCommon() T {
+func (c *Counter[T]) MostCommon() T {
 	var i T
 	max := math.MinInt
-	for item, count := range c {
+	for item, count := range c.counts {
 		if count > max {
 			max = count
 			i = item
@@ -124,10 +249,10 @@ func (c Counter[T]) MostCommon() T {
 
 // LeastCommon is used to get the least common (lowest count) item. Use
 // Counter.Get() to get the count of that item.
-func (c Counter[T]) LeastCommon() T {
+func (c *Counter[T]) LeastCommon() T {
 	var i T
 	min := math.MaxInt
-	for item, count := range c {
+	for item, count := range c.counts {
 		if count < min {
 			min = count
 			i = item
@@ -136,28 +261,135 @@ func (c Counter[T]) LeastCommon() T {
 	return i
 }
 
+// MostCommonN returns the n most common items along with their counts,
+// ordered from the most common to the least. If n is negative or is greater
+// than the number of items in the counter, all the items are returned.
+//
+// Items with equal counts are ordered using the compare function, which
+// returns a negative number when a < b, a positive number when a > b and zero
+// otherwise, like cmp.Compare for the ordered types. This makes the result
+// deterministic. If compare is nil, the items with equal counts are in an
+// unspecified order.
+func (c *Counter[T]) MostCommonN(n int, compare func(a, b T) int) []Entry[T] {
+	entries := c.entries()
+	sortEntries(entries, func(a, b int) int { return cmp.Compare(b, a) }, compare)
+	return entries[:c.limit(n)]
+}
+
+// LeastCommonN returns the n least common items along with their counts,
+// ordered from the least common to the most. If n is negative or is greater
+// than the number of items in the counter, all the items are returned.
+//
+// Ties are broken using the compare function in the same way as MostCommonN.
+func (c *Counter[T]) LeastCommonN(n int, compare func(a, b T) int) []Entry[T] {
+	entries := c.entries()
+	sortEntries(entries, cmp.Compare[int], compare)
+	return entries[:c.limit(n)]
+}
+
+// SortedByCount returns all the items in the counter along with their counts,
+// ordered from the most common to the least. This is equivalent to
+// MostCommonN(-1, compare).
+func (c *Counter[T]) SortedByCount(compare func(a, b T) int) []Entry[T] {
+	return c.MostCommonN(-1, compare)
+}
+
+// SortedByKey returns all the items in the counter along with their counts,
+// ordered by the items using the given less function. Items which are
+// neither less than one another are ordered from the most common to the
+// least.
+func (c *Counter[T]) SortedByKey(less func(a, b T) bool) []Entry[T] {
+	entries := c.entries()
+	slices.SortFunc(entries, func(a, b Entry[T]) int {
+		switch {
+		case less(a.Item, b.Item):
+			return -1
+		case less(b.Item, a.Item):
+			return 1
+		default:
+			return cmp.Compare(b.Count, a.Count)
+		}
+	})
+	return entries
+}
+
+// Clone returns a copy of the counter. Modifying the clone does not affect the
+// receiver and vice versa.
+func (c *Counter[T]) Clone() *Counter[T] {
+	return NewFromMap(c.counts)
+}
+
+// ToMap returns a copy of the counter as a map from items to their counts.
+func (c *Counter[T]) ToMap() map[T]int {
+	m := make(map[T]int, c.Len())
+	for item, count := range c.counts {
+		m[item] = count
+	}
+	return m
+}
+
 // Len returns the number of elements in the counter.
-func (c Counter[T]) Len() int {
-	return len(c)
+func (c *Counter[T]) Len() int {
+	return len(c.counts)
+}
+
+// Total returns the total count of all the elements in the counter. This is a
+// constant time operation.
+func (c *Counter[T]) Total() int {
+	return c.total
 }
 
-// Total returns the total count of all the elements in the counter.
-func (c Counter[T]) Total() int {
-	total := 0
-	for _, count := range c {
-		total += count
+// Frequencies returns a map from every item in the counter to its count
+// relative to the total count of all the items. If the total is zero, then
+// the frequency of every item is zero as well.
+func (c *Counter[T]) Frequencies() map[T]float64 {
+	freqs := make(map[T]float64, c.Len())
+	for item, count := range c.counts {
+		if c.total == 0 {
+			freqs[item] = 0
+		} else {
+			freqs[item] = float64(count) / float64(c.total)
+		}
 	}
-	return total
+	return freqs
 }
 
 // ForEach is used to iterate over every item of the counter by calling a
 // user-defined function with every item and its count.
-func (c Counter[T]) ForEach(f func(item T, count int)) {
-	for item, count := range c {
+func (c *Counter[T]) ForEach(f func(item T, count int)) {
+	for item, count := range c.counts {
 		f(item, count)
 	}
 }
 
+// ForEachSorted is similar to ForEach except that the items are visited in the
+// order defined by the given less function. Items which are neither less than
+// one another are visited from the most common to the least.
+func (c *Counter[T]) ForEachSorted(less func(a, b T) bool, f func(item T, count int)) {
+	for _, e := range c.SortedByKey(less) {
+		f(e.Item, e.Count)
+	}
+}
+
+// Keys returns all the items in the counter in an arbitrary order.
+func (c *Counter[T]) Keys() []T {
+	keys := make([]T, 0, c.Len())
+	for item := range c.counts {
+		keys = append(keys, item)
+	}
+	return keys
+}
+
+// Values returns the counts of all the items in the counter in an arbitrary
+// order. The order is not guaranteed to match the one returned by Keys.
+func (c *Counter[T]) Values() []int {
+	values := make([]int, 0, c.Len())
+	for _, count := range c.counts {
+		values = append(values, count)
+	}
+	return values
+}
+
 // Iter is used to iterate over every item of the counter. It returns a
 // receive-only buffered channel whose size is half of the counter length.
 //
@@ -167,11 +399,11 @@ func (c Counter[T]) ForEach(f func(item T, count int)) {
 //	  count := counter.Get(item)
 //	  // do something with item and count
 //	}
-func (c Counter[T]) Iter() <-chan T {
+func (c *Counter[T]) Iter() <-chan T {
 	// Use a buffered channel to avoid blocking the main goroutine.
 	ch := make(chan T, c.Len()/2)
 	go func() {
-		for item := range c {
+		for item := range c.counts {
 			ch <- item
 		}
 		close(ch)
@@ -179,16 +411,62 @@ func (c Counter[T]) Iter() <-chan T {
 	return ch
 }
 
-func (c Counter[T]) String() string {
+// Elements returns an iterator over the items of the counter where each item
+// is repeated as many times as its count. Items with a count of zero or less
+// are skipped. The order of the items is arbitrary, but all the occurrences of
+// an item are yielded together.
+func (c *Counter[T]) Elements() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item, count := range c.counts {
+			for ; count > 0; count-- {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (c *Counter[T]) String() string {
 	counts := make([]string, 0, c.Len())
-	for item, count := range c {
+	for item, count := range c.counts {
 		counts = append(counts, fmt.Sprintf("%v:%d", item, count))
 	}
 	return fmt.Sprintf("Counter{%s}", strings.Join(counts, " "))
 }
 
+// entries returns all the items in the counter along with their counts in an
+// arbitrary order.
+func (c *Counter[T]) entries() []Entry[T] {
+	entries := make([]Entry[T], 0, c.Len())
+	for item, count := range c.counts {
+		entries = append(entries, Entry[T]{Item: item, Count: count})
+	}
+	return entries
+}
+
+// limit returns n clamped to the number of items in the counter where a
+// negative n means all the items.
+func (c *Counter[T]) limit(n int) int {
+	if n < 0 || n > c.Len() {
+		return c.Len()
+	}
+	return n
+}
+
+// sortEntries sorts the entries in place using compareCounts on the counts.
+// Ties are broken using compareItems, if it's not nil.
+func sortEntries[T comparable](entries []Entry[T], compareCounts func(a, b int) int, compareItems func(a, b T) int) {
+	slices.SortFunc(entries, func(a, b Entry[T]) int {
+		if c := compareCounts(a.Count, b.Count); c != 0 || compareItems == nil {
+			return c
+		}
+		return compareItems(a.Item, b.Item)
+	})
+}
+
 // Internal method to ease up checking whether an item exists in the counter.
-func (c Counter[T]) contains(item T) bool {
-	_, exist := c[item]
+func (c *Counter[T]) contains(item T) bool {
+	_, exist := c.counts[item]
 	return exist
 }
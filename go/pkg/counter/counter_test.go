@@ -0,0 +1,253 @@
+package counter
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestCounterMostCommonN(t *testing.T) {
+	c := NewFromSlice([]byte("abracadabra"))
+
+	testCases := []struct {
+		name     string
+		n        int
+		expected []Entry[byte]
+	}{
+		{name: "zero", n: 0, expected: []Entry[byte]{}},
+		{
+			name:     "top two",
+			n:        2,
+			expected: []Entry[byte]{{'a', 5}, {'b', 2}},
+		},
+		{
+			name:     "all items",
+			n:        -1,
+			expected: []Entry[byte]{{'a', 5}, {'b', 2}, {'r', 2}, {'c', 1}, {'d', 1}},
+		},
+		{
+			name:     "more than length",
+			n:        10,
+			expected: []Entry[byte]{{'a', 5}, {'b', 2}, {'r', 2}, {'c', 1}, {'d', 1}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := c.MostCommonN(tc.n, cmp.Compare[byte]); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCounterLeastCommonN(t *testing.T) {
+	c := NewFromSlice([]byte("abracadabra"))
+
+	expected := []Entry[byte]{{'c', 1}, {'d', 1}, {'b', 2}}
+	if actual := c.LeastCommonN(3, cmp.Compare[byte]); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
+
+func TestCounterMostCommonNCompare(t *testing.T) {
+	type pair struct{ a, b int }
+	c := New(pair{2, 1}, pair{1, 2}, pair{1, 1}, pair{3, 0}, pair{3, 0})
+	byFields := func(x, y pair) int {
+		if r := cmp.Compare(x.a, y.a); r != 0 {
+			return r
+		}
+		return cmp.Compare(x.b, y.b)
+	}
+
+	expected := []Entry[pair]{{pair{3, 0}, 2}, {pair{1, 1}, 1}, {pair{1, 2}, 1}, {pair{2, 1}, 1}}
+	if actual := c.MostCommonN(-1, byFields); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	// Without a compare function only the counts are ordered.
+	if actual := c.MostCommonN(1, nil); !reflect.DeepEqual(actual, expected[:1]) {
+		t.Errorf("c.MostCommonN(1, nil)\nexpected: %v\nactual: %v\n", expected[:1], actual)
+	}
+}
+
+func TestCounterArithmetic(t *testing.T) {
+	c1 := NewFromMap(map[string]int{"a": 3, "b": 1, "c": -1})
+	c2 := NewFromMap(map[string]int{"a": 1, "b": 2, "d": 4})
+
+	testCases := []struct {
+		name     string
+		actual   *Counter[string]
+		expected map[string]int
+	}{
+		{
+			name:     "add",
+			actual:   c1.Add(c2),
+			expected: map[string]int{"a": 4, "b": 3, "d": 4},
+		},
+		{
+			name:     "subtract",
+			actual:   c1.Subtract(c2),
+			expected: map[string]int{"a": 2},
+		},
+		{
+			name:     "union",
+			actual:   c1.Union(c2),
+			expected: map[string]int{"a": 3, "b": 2, "d": 4},
+		},
+		{
+			name:     "intersection",
+			actual:   c1.Intersection(c2),
+			expected: map[string]int{"a": 1, "b": 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assertCounts(t, tc.actual, tc.expected)
+		})
+	}
+
+	// The operands should not be modified.
+	assertCounts(t, c1, map[string]int{"a": 3, "b": 1, "c": -1})
+}
+
+func TestCounterElements(t *testing.T) {
+	c := NewFromMap(map[rune]int{'a': 2, 'b': 0, 'c': -1, 'd': 1})
+
+	elements := slices.Collect(c.Elements())
+	slices.Sort(elements)
+	if expected := []rune{'a', 'a', 'd'}; !reflect.DeepEqual(elements, expected) {
+		t.Errorf("\nexpected: %q\nactual: %q\n", expected, elements)
+	}
+}
+
+func TestCounterPrune(t *testing.T) {
+	c := NewFromMap(map[int]int{1: 1, 2: 2, 3: 3, 4: -4})
+
+	if n := c.CountIf(func(_, count int) bool { return count >= 2 }); n != 2 {
+		t.Errorf("c.CountIf(); expected: 2, actual: %d\n", n)
+	}
+
+	keys := c.KeysWithCountAtLeast(2)
+	slices.Sort(keys)
+	if expected := []int{2, 3}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("c.KeysWithCountAtLeast(2); expected: %v, actual: %v\n", expected, keys)
+	}
+
+	c.Prune(2)
+	assertCounts(t, c, map[int]int{2: 2, 3: 3})
+}
+
+func TestCounterTotal(t *testing.T) {
+	c := New("a", "b", "a")
+	if total := c.Total(); total != 3 {
+		t.Errorf("c.Total(); expected: 3, actual: %d\n", total)
+	}
+
+	c.IncrementBy("c", 4)
+	c.DecrementBy("a", 1)
+	c.Delete("b")
+	c.Update(NewFromMap(map[string]int{"a": 2, "d": -1}))
+	assertCounts(t, c, map[string]int{"a": 3, "c": 4, "d": -1})
+
+	c.KeepPositive()
+	assertCounts(t, c, map[string]int{"a": 3, "c": 4})
+}
+
+// assertCounts checks that the counter c contains exactly the expected
+// counts and that its running total is in sync with them.
+func assertCounts[T comparable](t *testing.T, c *Counter[T], expected map[T]int) {
+	t.Helper()
+	if !reflect.DeepEqual(c.counts, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, c.counts)
+	}
+	total := 0
+	for _, count := range expected {
+		total += count
+	}
+	if c.Total() != total {
+		t.Errorf("total; expected: %d, actual: %d\n", total, c.Total())
+	}
+}
+
+func TestCounterSortedByKey(t *testing.T) {
+	c := NewFromSlice([]byte("abracadabra"))
+
+	expected := []Entry[byte]{{'r', 2}, {'d', 1}, {'c', 1}, {'b', 2}, {'a', 5}}
+	actual := c.SortedByKey(func(a, b byte) bool { return a > b })
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
+
+func TestCounterClone(t *testing.T) {
+	c := New(1, 1, 2)
+	clone := c.Clone()
+	clone.Increment(1, 3)
+
+	assertCounts(t, c, map[int]int{1: 2, 2: 1})
+	assertCounts(t, clone, map[int]int{1: 3, 2: 1, 3: 1})
+
+	m := c.ToMap()
+	m[1] = 10
+	assertCounts(t, c, map[int]int{1: 2, 2: 1})
+}
+
+func TestCounterTopK(t *testing.T) {
+	c := NewFromSlice([]byte("mississippi river"))
+
+	for k := -1; k <= c.Len()+1; k++ {
+		expected := c.MostCommonN(k, cmp.Compare[byte])
+		if actual := c.TopK(k, cmp.Compare[byte]); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("c.TopK(%d)\nexpected: %v\nactual: %v\n", k, expected, actual)
+		}
+	}
+}
+
+func TestCounterForEachSorted(t *testing.T) {
+	c := New("b", "a", "c", "a")
+
+	var order []string
+	c.ForEachSorted(func(a, b string) bool { return a < b }, func(item string, count int) {
+		order = append(order, fmt.Sprintf("%s:%d", item, count))
+	})
+	if expected := []string{"a:2", "b:1", "c:1"}; !reflect.DeepEqual(order, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, order)
+	}
+
+	keys, values := c.Keys(), c.Values()
+	slices.Sort(keys)
+	slices.Sort(values)
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual(keys, expected) {
+		t.Errorf("c.Keys(); expected: %v, actual: %v\n", expected, keys)
+	}
+	if expected := []int{1, 1, 2}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("c.Values(); expected: %v, actual: %v\n", expected, values)
+	}
+}
+
+func TestCounterFrequencies(t *testing.T) {
+	c := New(1, 2, 2, 3)
+
+	expected := map[int]float64{1: 0.25, 2: 0.5, 3: 0.25}
+	if freqs := c.Frequencies(); !reflect.DeepEqual(freqs, expected) {
+		t.Errorf("c.Frequencies(); expected: %v, actual: %v\n", expected, freqs)
+	}
+
+	c.Scale(3)
+	assertCounts(t, c, map[int]int{1: 3, 2: 6, 3: 3})
+	if freqs := c.Frequencies(); !reflect.DeepEqual(freqs, expected) {
+		t.Errorf("c.Frequencies() after scaling; expected: %v, actual: %v\n", expected, freqs)
+	}
+}
+
+func TestCounterMerge(t *testing.T) {
+	c1, c2, c3 := New("a", "b"), New("b", "c"), New[string]()
+	c3.DecrementBy("a", 2)
+
+	assertCounts(t, Merge(c1, c2, c3), map[string]int{"a": -1, "b": 2, "c": 1})
+	assertCounts(t, Merge[string](), map[string]int{})
+}
@@ -0,0 +1,52 @@
+package counter
+
+import (
+	"slices"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/heap"
+)
+
+// TopK returns the k most common items along with their counts, ordered from
+// the most common to the least. The result is the same as MostCommonN(k,
+// compare), including the tie-breaking rules, but it is computed using a
+// bounded heap of size k instead of sorting all the items. This makes it
+// O(n log k) which is considerably faster for large counters when k is small.
+//
+// If k is negative or is greater than the number of items in the counter, all
+// the items are returned.
+func (c *Counter[T]) TopK(k int, compare func(a, b T) int) []Entry[T] {
+	k = c.limit(k)
+	if k == 0 {
+		return []Entry[T]{}
+	}
+
+	// The root of the heap is the least common entry among the k entries.
+	less := func(a, b Entry[T]) bool { return lessCommon(a, b, compare) }
+	h := heap.New(less)
+	for item, count := range c.counts {
+		e := Entry[T]{Item: item, Count: count}
+		if h.Len() < k {
+			h.Push(e)
+		} else if worst, _ := h.Peek(); less(worst, e) {
+			// The new entry is better than the worst entry in the heap.
+			h.Pop()
+			h.Push(e)
+		}
+	}
+
+	entries := make([]Entry[T], 0, k)
+	for e, ok := h.Pop(); ok; e, ok = h.Pop() {
+		entries = append(entries, e)
+	}
+	slices.Reverse(entries)
+	return entries
+}
+
+// lessCommon reports whether a would be ordered after b by MostCommonN using
+// the same compare function.
+func lessCommon[T comparable](a, b Entry[T], compare func(a, b T) int) bool {
+	if a.Count != b.Count || compare == nil {
+		return a.Count < b.Count
+	}
+	return compare(a.Item, b.Item) > 0
+}
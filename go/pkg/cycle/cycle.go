@@ -0,0 +1,77 @@
+// Package cycle implements the helpers to detect a cycle in a simulation of
+// repeated steps and extrapolate the result after a large number of steps.
+//
+// Every simulation on a finite state space eventually repeats a state, after
+// which it goes through the same states in a cycle. The state after n steps
+// is then the same as the state after μ + (n-μ) % λ steps where μ is the step
+// at which the cycle starts and λ is the length of the cycle.
+package cycle
+
+// Find runs the step function starting from the initial state until a state
+// repeats, as identified by the key function. It returns the step at which
+// the cycle starts, the length of the cycle, and all the states from the
+// initial state up to, but not including, the first repeated state. That is,
+// states[i] is the state after i steps.
+//
+// The function does not return if the states never repeat.
+func Find[S any, K comparable](initial S, step func(S) S, key func(S) K) (start, length int, states []S) {
+	seen := make(map[K]int)
+	s := initial
+	for i := 0; ; i++ {
+		k := key(s)
+		if j, ok := seen[k]; ok {
+			return j, i - j, states
+		}
+		seen[k] = i
+		states = append(states, s)
+		s = step(s)
+	}
+}
+
+// StateAt returns the state after running the step function n times starting
+// from the initial state. The steps are only run until a cycle is found,
+// after which the state is extrapolated.
+func StateAt[S any, K comparable](initial S, n int, step func(S) S, key func(S) K) S {
+	seen := make(map[K]int)
+	var states []S
+	s := initial
+	for i := 0; i < n; i++ {
+		k := key(s)
+		if j, ok := seen[k]; ok {
+			return states[j+(n-j)%(i-j)]
+		}
+		seen[k] = i
+		states = append(states, s)
+		s = step(s)
+	}
+	return s
+}
+
+// Extrapolate returns the score after running the step function n times
+// where the state is kept by the caller, like a mutable simulation. The key
+// function identifies the current state and the score function returns the
+// current score, like the height of a tower.
+//
+// The steps are only run until a cycle is found. As the score changes by the
+// same amount in every iteration of the cycle, the score after n steps is the
+// score at the equivalent step in the first iteration plus the change over
+// all the full iterations. The n need not be aligned with the cycle.
+func Extrapolate[K comparable](n int, key func() K, score func() int, step func()) int {
+	seen := make(map[K]int)
+	var scores []int
+	for i := 0; ; i++ {
+		s := score()
+		if i == n {
+			return s
+		}
+		k := key()
+		if j, ok := seen[k]; ok {
+			length, delta := i-j, s-scores[j]
+			cycles, remainder := (n-j)/length, (n-j)%length
+			return scores[j+remainder] + cycles*delta
+		}
+		seen[k] = i
+		scores = append(scores, s)
+		step()
+	}
+}
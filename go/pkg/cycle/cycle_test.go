@@ -0,0 +1,80 @@
+package cycle
+
+import (
+	"reflect"
+	"testing"
+)
+
+// next is a step function for the numbers which enter a cycle of length 4
+// after a tail of length 3: 0 -> 1 -> 2 -> 3 -> 4 -> 5 -> 6 -> 3 -> ...
+func next(n int) int {
+	if n == 6 {
+		return 3
+	}
+	return n + 1
+}
+
+func identity(n int) int {
+	return n
+}
+
+func TestFind(t *testing.T) {
+	start, length, states := Find(0, next, identity)
+	if start != 3 || length != 4 {
+		t.Errorf("expected cycle at 3 of length 4, got cycle at %d of length %d\n", start, length)
+	}
+	if expected := []int{0, 1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(states, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, states)
+	}
+}
+
+func TestStateAt(t *testing.T) {
+	testCases := []struct {
+		n        int
+		expected int
+	}{
+		{n: 0, expected: 0},
+		{n: 2, expected: 2},
+		{n: 6, expected: 6},
+		{n: 7, expected: 3},
+		{n: 10, expected: 6},
+		{n: 1_000_000_000, expected: 4},
+	}
+
+	for _, tc := range testCases {
+		if actual := StateAt(0, tc.n, next, identity); actual != tc.expected {
+			t.Errorf("StateAt(%d); expected: %d, actual: %d\n", tc.n, tc.expected, actual)
+		}
+	}
+}
+
+func TestExtrapolate(t *testing.T) {
+	for _, n := range []int{0, 5, 7, 8, 9, 10, 11, 1000, 1_000_000_001} {
+		// The score increases by the state after every step, so it increases
+		// by 3+4+5+6 in every iteration of the cycle.
+		state, score := 0, 0
+		actual := Extrapolate(n, func() int { return state }, func() int { return score }, func() {
+			state = next(state)
+			score += state
+		})
+
+		expected := 0
+		for s, i := 0, 0; i < n && i < 1000; i++ {
+			s = next(s)
+			expected += s
+		}
+		if n > 1000 {
+			// 1+2+3 for the first three steps, after which the full cycles
+			// of 4+5+6+3 are followed by the remaining steps.
+			cycles, remainder := (n-3)/4, (n-3)%4
+			expected = 1 + 2 + 3 + cycles*18
+			for s, i := 3, 0; i < remainder; i++ {
+				s = next(s)
+				expected += s
+			}
+		}
+		if actual != expected {
+			t.Errorf("Extrapolate(%d); expected: %d, actual: %d\n", n, expected, actual)
+		}
+	}
+}
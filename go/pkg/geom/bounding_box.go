@@ -1,6 +1,10 @@
 package geom
 
-import "github.com/dhruvmanila/advent-of-code/go/util"
+import (
+	"iter"
+
+	"github.com/dhruvmanila/advent-of-code/go/util"
+)
 
 // BoundingBox2D contains information about coordinates of a rectangular border.
 // This could represent the minimum and maximum value of X and Y coorindates in
@@ -22,6 +26,22 @@ func NewBoundingBox2D(minx, maxx, miny, maxy int) *BoundingBox2D {
 	}
 }
 
+// NewBoundingBox2DFromPoints creates the smallest two dimensional bounding box
+// which contains all the given points, nil if there are no points.
+func NewBoundingBox2DFromPoints(points []Point2D[int]) *BoundingBox2D {
+	if len(points) == 0 {
+		return nil
+	}
+	b := NewBoundingBox2D(points[0].X, points[0].X, points[0].Y, points[0].Y)
+	for _, p := range points[1:] {
+		b.MinX = util.Min(b.MinX, p.X)
+		b.MaxX = util.Max(b.MaxX, p.X)
+		b.MinY = util.Min(b.MinY, p.Y)
+		b.MaxY = util.Max(b.MaxY, p.Y)
+	}
+	return b
+}
+
 // Contains is used to check whether the point formed by given x and y values
 // is within the bounds of the box.
 func (b *BoundingBox2D) Contains(x, y int) bool {
@@ -42,11 +62,89 @@ func (b *BoundingBox2D) Intersection(other *BoundingBox2D) *BoundingBox2D {
 	return nil
 }
 
+// Union returns the smallest bounding box which contains both bbox2d and
+// other.
+func (b *BoundingBox2D) Union(other *BoundingBox2D) *BoundingBox2D {
+	return NewBoundingBox2D(
+		util.Min(b.MinX, other.MinX),
+		util.Max(b.MaxX, other.MaxX),
+		util.Min(b.MinY, other.MinY),
+		util.Max(b.MaxY, other.MaxY),
+	)
+}
+
+// Subtract returns the disjoint bounding boxes which together cover all the
+// points of bbox2d which are not in other. The result contains at most 4
+// boxes, is empty if other covers bbox2d completely, and contains bbox2d as
+// is if they do not intersect.
+func (b *BoundingBox2D) Subtract(other *BoundingBox2D) []*BoundingBox2D {
+	i := b.Intersection(other)
+	if i == nil {
+		return []*BoundingBox2D{b}
+	}
+	var pieces []*BoundingBox2D
+	// The slabs on the left and right of the intersection span the entire
+	// height while the ones above and below it span only its width.
+	if b.MinX < i.MinX {
+		pieces = append(pieces, NewBoundingBox2D(b.MinX, i.MinX-1, b.MinY, b.MaxY))
+	}
+	if i.MaxX < b.MaxX {
+		pieces = append(pieces, NewBoundingBox2D(i.MaxX+1, b.MaxX, b.MinY, b.MaxY))
+	}
+	if b.MinY < i.MinY {
+		pieces = append(pieces, NewBoundingBox2D(i.MinX, i.MaxX, b.MinY, i.MinY-1))
+	}
+	if i.MaxY < b.MaxY {
+		pieces = append(pieces, NewBoundingBox2D(i.MinX, i.MaxX, i.MaxY+1, b.MaxY))
+	}
+	return pieces
+}
+
+// Expand returns a new bounding box which is bbox2d grown by n in every
+// direction. A negative n shrinks the box instead.
+func (b *BoundingBox2D) Expand(n int) *BoundingBox2D {
+	return NewBoundingBox2D(b.MinX-n, b.MaxX+n, b.MinY-n, b.MaxY+n)
+}
+
 // Area returns the area of the bounding box.
 func (b *BoundingBox2D) Area() int {
 	return (b.MaxX - b.MinX + 1) * (b.MaxY - b.MinY + 1)
 }
 
+// Wrap returns the point p wrapped around bbox2d as if the opposite edges of
+// the box were connected, i.e., the box is a torus. A point just past the
+// maximum X is wrapped to the minimum X and vice versa, and the same for Y.
+// Points which are already inside the box are returned as is.
+func (b *BoundingBox2D) Wrap(p Point2D[int]) Point2D[int] {
+	return Point2D[int]{
+		X: b.MinX + util.Mod(p.X-b.MinX, b.MaxX-b.MinX+1),
+		Y: b.MinY + util.Mod(p.Y-b.MinY, b.MaxY-b.MinY+1),
+	}
+}
+
+// Clamp returns the point inside bbox2d which is closest to p, i.e., each
+// coordinate of p outside the box is moved to the nearest edge.
+func (b *BoundingBox2D) Clamp(p Point2D[int]) Point2D[int] {
+	return Point2D[int]{
+		X: util.Clamp(p.X, b.MinX, b.MaxX),
+		Y: util.Clamp(p.Y, b.MinY, b.MaxY),
+	}
+}
+
+// Points returns an iterator over all the points with integer coordinates
+// contained in bbox2d in row major order, i.e., ordered by Y and then X.
+func (b *BoundingBox2D) Points() iter.Seq[Point2D[int]] {
+	return func(yield func(Point2D[int]) bool) {
+		for y := b.MinY; y <= b.MaxY; y++ {
+			for x := b.MinX; x <= b.MaxX; x++ {
+				if !yield(Point2D[int]{X: x, Y: y}) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // BoundingBox3D is similar to BoundingBox2D, except this represents a three
 // dimensional cuboid.
 type BoundingBox3D struct {
@@ -94,7 +192,99 @@ func (b *BoundingBox3D) Intersection(other *BoundingBox3D) *BoundingBox3D {
 	return nil
 }
 
+// Union returns the smallest bounding box which contains both bbox3d and
+// other.
+func (b *BoundingBox3D) Union(other *BoundingBox3D) *BoundingBox3D {
+	return NewBoundingBox3D(
+		util.Min(b.MinX, other.MinX),
+		util.Max(b.MaxX, other.MaxX),
+		util.Min(b.MinY, other.MinY),
+		util.Max(b.MaxY, other.MaxY),
+		util.Min(b.MinZ, other.MinZ),
+		util.Max(b.MaxZ, other.MaxZ),
+	)
+}
+
+// Subtract returns the disjoint bounding boxes which together cover all the
+// points of bbox3d which are not in other. The result contains at most 6
+// boxes, is empty if other covers bbox3d completely, and contains bbox3d as
+// is if they do not intersect.
+func (b *BoundingBox3D) Subtract(other *BoundingBox3D) []*BoundingBox3D {
+	i := b.Intersection(other)
+	if i == nil {
+		return []*BoundingBox3D{b}
+	}
+	var pieces []*BoundingBox3D
+	// The box is cut along the X axis first, then the remaining part along
+	// the Y axis and then along the Z axis, so that the pieces never overlap.
+	if b.MinX < i.MinX {
+		pieces = append(pieces, NewBoundingBox3D(b.MinX, i.MinX-1, b.MinY, b.MaxY, b.MinZ, b.MaxZ))
+	}
+	if i.MaxX < b.MaxX {
+		pieces = append(pieces, NewBoundingBox3D(i.MaxX+1, b.MaxX, b.MinY, b.MaxY, b.MinZ, b.MaxZ))
+	}
+	if b.MinY < i.MinY {
+		pieces = append(pieces, NewBoundingBox3D(i.MinX, i.MaxX, b.MinY, i.MinY-1, b.MinZ, b.MaxZ))
+	}
+	if i.MaxY < b.MaxY {
+		pieces = append(pieces, NewBoundingBox3D(i.MinX, i.MaxX, i.MaxY+1, b.MaxY, b.MinZ, b.MaxZ))
+	}
+	if b.MinZ < i.MinZ {
+		pieces = append(pieces, NewBoundingBox3D(i.MinX, i.MaxX, i.MinY, i.MaxY, b.MinZ, i.MinZ-1))
+	}
+	if i.MaxZ < b.MaxZ {
+		pieces = append(pieces, NewBoundingBox3D(i.MinX, i.MaxX, i.MinY, i.MaxY, i.MaxZ+1, b.MaxZ))
+	}
+	return pieces
+}
+
+// Split divides bbox3d into the 8 octant boxes formed by halving it along every
+// axis. The octants are disjoint and together they cover bbox3d exactly. If
+// bbox3d is only a single unit wide along an axis, it cannot be halved along
+// that axis, so fewer octants are returned in that case.
+func (b *BoundingBox3D) Split() []*BoundingBox3D {
+	xs := splitRange(b.MinX, b.MaxX)
+	ys := splitRange(b.MinY, b.MaxY)
+	zs := splitRange(b.MinZ, b.MaxZ)
+
+	octants := make([]*BoundingBox3D, 0, len(xs)*len(ys)*len(zs))
+	for _, x := range xs {
+		for _, y := range ys {
+			for _, z := range zs {
+				octants = append(octants, NewBoundingBox3D(x[0], x[1], y[0], y[1], z[0], z[1]))
+			}
+		}
+	}
+	return octants
+}
+
 // Volume returns the volume of the bounding box.
 func (b *BoundingBox3D) Volume() int {
 	return (b.MaxX - b.MinX + 1) * (b.MaxY - b.MinY + 1) * (b.MaxZ - b.MinZ + 1)
 }
+
+// Points returns an iterator over all the points with integer coordinates
+// contained in bbox3d, ordered by Z, then Y and then X.
+func (b *BoundingBox3D) Points() iter.Seq[Point3D[int]] {
+	return func(yield func(Point3D[int]) bool) {
+		for z := b.MinZ; z <= b.MaxZ; z++ {
+			for y := b.MinY; y <= b.MaxY; y++ {
+				for x := b.MinX; x <= b.MaxX; x++ {
+					if !yield(Point3D[int]{X: x, Y: y, Z: z}) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// splitRange divides the inclusive range [min, max] into two halves. A range
+// containing a single value is returned as is.
+func splitRange(min, max int) [][2]int {
+	if min == max {
+		return [][2]int{{min, max}}
+	}
+	mid := min + (max-min)/2
+	return [][2]int{{min, mid}, {mid + 1, max}}
+}
@@ -2,6 +2,7 @@ package geom
 
 import (
 	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -118,3 +119,205 @@ func TestBoundingBox3DVolume(t *testing.T) {
 		t.Errorf("\nbbox: %#v\nexpected: %v\nactual: %v\n", bbox, expected, volume)
 	}
 }
+
+func TestBoundingBox2DFromPoints(t *testing.T) {
+	points := []Point2D[int]{{3, -1}, {0, 4}, {-2, 2}}
+	expected := NewBoundingBox2D(-2, 3, -1, 4)
+	if actual := NewBoundingBox2DFromPoints(points); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\npoints: %v\nexpected: %v\nactual: %v\n", points, expected, actual)
+	}
+	if actual := NewBoundingBox2DFromPoints(nil); actual != nil {
+		t.Errorf("\nno points\nexpected: nil\nactual: %v\n", actual)
+	}
+}
+
+func TestBoundingBox2DUnion(t *testing.T) {
+	bbox := NewBoundingBox2D(0, 5, 0, 5)
+	other := NewBoundingBox2D(3, 9, -2, 1)
+	expected := NewBoundingBox2D(0, 9, -2, 5)
+	if actual := bbox.Union(other); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nbbox: %#v\nother: %#v\nexpected: %v\nactual: %v\n", bbox, other, expected, actual)
+	}
+}
+
+func TestBoundingBox2DExpand(t *testing.T) {
+	bbox := NewBoundingBox2D(0, 5, 2, 3)
+	expected := NewBoundingBox2D(-1, 6, 1, 4)
+	if actual := bbox.Expand(1); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nbbox: %#v\nexpected: %v\nactual: %v\n", bbox, expected, actual)
+	}
+}
+
+func TestBoundingBox3DUnion(t *testing.T) {
+	bbox := NewBoundingBox3D(0, 5, 0, 5, 0, 5)
+	other := NewBoundingBox3D(3, 9, -2, 1, 4, 4)
+	expected := NewBoundingBox3D(0, 9, -2, 5, 0, 5)
+	if actual := bbox.Union(other); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nbbox: %#v\nother: %#v\nexpected: %v\nactual: %v\n", bbox, other, expected, actual)
+	}
+}
+
+func TestBoundingBox3DSplit(t *testing.T) {
+	testCases := []struct {
+		name  string
+		bbox  *BoundingBox3D
+		count int
+	}{
+		{name: "even cube", bbox: NewBoundingBox3D(-4, 3, 0, 7, 10, 17), count: 8},
+		{name: "odd cuboid", bbox: NewBoundingBox3D(0, 4, 0, 2, 0, 6), count: 8},
+		{name: "flat cuboid", bbox: NewBoundingBox3D(0, 4, 0, 4, 3, 3), count: 4},
+		{name: "unit cube", bbox: NewBoundingBox3D(1, 1, 1, 1, 1, 1), count: 1},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.name, func(t *testing.T) {
+			octants := c.bbox.Split()
+			if len(octants) != c.count {
+				t.Fatalf("\nbbox: %#v\nexpected octants: %d\nactual: %d\n", c.bbox, c.count, len(octants))
+			}
+			volume := 0
+			for i, o := range octants {
+				volume += o.Volume()
+				for _, other := range octants[i+1:] {
+					if o.Intersection(other) != nil {
+						t.Errorf("\noctants intersect: %v and %v\n", o, other)
+					}
+				}
+			}
+			if volume != c.bbox.Volume() {
+				t.Errorf("\nbbox: %#v\nexpected volume: %d\nactual: %d\n", c.bbox, c.bbox.Volume(), volume)
+			}
+		})
+	}
+}
+
+func TestBoundingBox2DPoints(t *testing.T) {
+	bbox := NewBoundingBox2D(-1, 1, 2, 3)
+	expected := []Point2D[int]{{-1, 2}, {0, 2}, {1, 2}, {-1, 3}, {0, 3}, {1, 3}}
+	if actual := slices.Collect(bbox.Points()); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nbbox: %#v\nexpected: %v\nactual: %v\n", bbox, expected, actual)
+	}
+}
+
+func TestBoundingBox3DPoints(t *testing.T) {
+	bbox := NewBoundingBox3D(0, 2, -1, 1, 4, 7)
+	count := 0
+	for p := range bbox.Points() {
+		if !bbox.Contains(p.X, p.Y, p.Z) {
+			t.Errorf("\nbbox: %#v\npoint outside the box: %v\n", bbox, p)
+		}
+		count++
+	}
+	if count != bbox.Volume() {
+		t.Errorf("\nbbox: %#v\nexpected points: %d\nactual: %d\n", bbox, bbox.Volume(), count)
+	}
+}
+
+func TestBoundingBox2DWrapClamp(t *testing.T) {
+	bbox := NewBoundingBox2D(1, 5, -2, 2)
+
+	testCases := []struct {
+		name    string
+		point   Point2D[int]
+		wrapped Point2D[int]
+		clamped Point2D[int]
+	}{
+		{name: "inside", point: Point2D[int]{3, 0}, wrapped: Point2D[int]{3, 0}, clamped: Point2D[int]{3, 0}},
+		{name: "past max", point: Point2D[int]{6, 3}, wrapped: Point2D[int]{1, -2}, clamped: Point2D[int]{5, 2}},
+		{name: "before min", point: Point2D[int]{0, -3}, wrapped: Point2D[int]{5, 2}, clamped: Point2D[int]{1, -2}},
+		{name: "far away", point: Point2D[int]{-13, 24}, wrapped: Point2D[int]{2, -1}, clamped: Point2D[int]{1, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := bbox.Wrap(tc.point); actual != tc.wrapped {
+				t.Errorf("\nWrap(%v)\nexpected: %v\nactual: %v\n", tc.point, tc.wrapped, actual)
+			}
+			if actual := bbox.Clamp(tc.point); actual != tc.clamped {
+				t.Errorf("\nClamp(%v)\nexpected: %v\nactual: %v\n", tc.point, tc.clamped, actual)
+			}
+		})
+	}
+}
+
+func TestBoundingBox2DSubtract(t *testing.T) {
+	bbox := NewBoundingBox2D(0, 9, 0, 9)
+
+	testCases := []struct {
+		name  string
+		other *BoundingBox2D
+		count int
+	}{
+		{name: "disjoint", other: NewBoundingBox2D(20, 30, 0, 9), count: 1},
+		{name: "covered", other: NewBoundingBox2D(-1, 10, -1, 10), count: 0},
+		{name: "center", other: NewBoundingBox2D(3, 5, 3, 5), count: 4},
+		{name: "corner", other: NewBoundingBox2D(5, 15, -5, 4), count: 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pieces := bbox.Subtract(tc.other)
+			if len(pieces) != tc.count {
+				t.Fatalf("\nexpected pieces: %d\nactual: %v\n", tc.count, pieces)
+			}
+			for p := range bbox.Points() {
+				covered := 0
+				for _, piece := range pieces {
+					if piece.Contains(p.X, p.Y) {
+						covered++
+					}
+				}
+				expected := 1
+				if tc.other.Contains(p.X, p.Y) {
+					expected = 0
+				}
+				if covered != expected {
+					t.Fatalf("\npoint: %v\nexpected covered by %d pieces, actual: %d\n", p, expected, covered)
+				}
+			}
+		})
+	}
+}
+
+func TestBoundingBox3DSubtract(t *testing.T) {
+	bbox := NewBoundingBox3D(0, 5, 0, 5, 0, 5)
+
+	testCases := []struct {
+		name  string
+		other *BoundingBox3D
+		count int
+	}{
+		{name: "disjoint", other: NewBoundingBox3D(0, 5, 0, 5, 6, 9), count: 1},
+		{name: "covered", other: NewBoundingBox3D(0, 5, 0, 5, 0, 5), count: 0},
+		{name: "center", other: NewBoundingBox3D(2, 3, 2, 3, 2, 3), count: 6},
+		{name: "corner", other: NewBoundingBox3D(3, 9, 3, 9, -9, 2), count: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pieces := bbox.Subtract(tc.other)
+			if len(pieces) != tc.count {
+				t.Fatalf("\nexpected pieces: %d\nactual: %v\n", tc.count, pieces)
+			}
+			volume := 0
+			for i, piece := range pieces {
+				volume += piece.Volume()
+				if piece.Intersection(tc.other) != nil {
+					t.Errorf("\npiece intersects the subtracted box: %v\n", piece)
+				}
+				for _, other := range pieces[i+1:] {
+					if piece.Intersection(other) != nil {
+						t.Errorf("\npieces intersect: %v and %v\n", piece, other)
+					}
+				}
+			}
+			expected := bbox.Volume()
+			if i := bbox.Intersection(tc.other); i != nil {
+				expected -= i.Volume()
+			}
+			if volume != expected {
+				t.Errorf("\nexpected volume: %d\nactual: %d\n", expected, volume)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package direction
 
-import "github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+import (
+	"fmt"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+)
 
 // Type is the direction type.
 type Type int
@@ -10,13 +14,85 @@ const (
 	Down
 	Left
 	Up
+	UpRight
+	DownRight
+	DownLeft
+	UpLeft
 )
 
 var directionDelta = map[Type]geom.Point2D[int]{
-	Right: {X: 1, Y: 0},
-	Down:  {X: 0, Y: 1},
-	Left:  {X: -1, Y: 0},
-	Up:    {X: 0, Y: -1},
+	Right:     {X: 1, Y: 0},
+	Down:      {X: 0, Y: 1},
+	Left:      {X: -1, Y: 0},
+	Up:        {X: 0, Y: -1},
+	UpRight:   {X: 1, Y: -1},
+	DownRight: {X: 1, Y: 1},
+	DownLeft:  {X: -1, Y: 1},
+	UpLeft:    {X: -1, Y: -1},
+}
+
+// FromArrow returns the direction for the given arrow character which is one
+// of '^', 'v', '<' or '>'.
+func FromArrow(r rune) (Type, error) {
+	switch r {
+	case '^':
+		return Up, nil
+	case 'v':
+		return Down, nil
+	case '<':
+		return Left, nil
+	case '>':
+		return Right, nil
+	}
+	return 0, fmt.Errorf("direction: invalid arrow %q", r)
+}
+
+// FromLetter returns the direction for the given letter which is one of 'U',
+// 'D', 'L' or 'R'.
+func FromLetter(r rune) (Type, error) {
+	switch r {
+	case 'U':
+		return Up, nil
+	case 'D':
+		return Down, nil
+	case 'L':
+		return Left, nil
+	case 'R':
+		return Right, nil
+	}
+	return 0, fmt.Errorf("direction: invalid letter %q", r)
+}
+
+// FromCompass returns the direction for the given compass point which is one
+// of 'N', 'S', 'E' or 'W'. North is up and east is right.
+func FromCompass(r rune) (Type, error) {
+	switch r {
+	case 'N':
+		return Up, nil
+	case 'S':
+		return Down, nil
+	case 'W':
+		return Left, nil
+	case 'E':
+		return Right, nil
+	}
+	return 0, fmt.Errorf("direction: invalid compass point %q", r)
+}
+
+// FromRune returns the direction for the given character which can be either
+// an arrow, a letter or a compass point as accepted by FromArrow, FromLetter
+// and FromCompass respectively.
+func FromRune(r rune) (Type, error) {
+	if d, err := FromArrow(r); err == nil {
+		return d, nil
+	}
+	if d, err := FromLetter(r); err == nil {
+		return d, nil
+	}
+	if d, err := FromCompass(r); err == nil {
+		return d, nil
+	}
+	return 0, fmt.Errorf("direction: invalid direction %q", r)
 }
 
 // Delta returns the difference in X and Y coordinates to move in the
@@ -34,6 +110,14 @@ func (d Type) Clockwise() Type {
 		return Left
 	case Left:
 		return Up
+	case UpRight:
+		return DownRight
+	case DownRight:
+		return DownLeft
+	case DownLeft:
+		return UpLeft
+	case UpLeft:
+		return UpRight
 	default:
 		return Right
 	}
@@ -49,11 +133,57 @@ func (d Type) CounterClockwise() Type {
 		return Right
 	case Left:
 		return Down
+	case UpRight:
+		return UpLeft
+	case DownRight:
+		return UpRight
+	case DownLeft:
+		return DownRight
+	case UpLeft:
+		return DownLeft
 	default:
 		return Left
 	}
 }
 
+// Clockwise45 returns the direction after rotating 45 degrees in the
+// clockwise manner.
+func (d Type) Clockwise45() Type {
+	return d.rotate45(1)
+}
+
+// CounterClockwise45 returns the direction after rotating 45 degrees in the
+// counter clockwise manner.
+func (d Type) CounterClockwise45() Type {
+	return d.rotate45(7)
+}
+
+// Opposite returns the direction pointing the opposite way.
+func (d Type) Opposite() Type {
+	return d.rotate45(4)
+}
+
+// IsCardinal returns true if the direction is one of Right, Down, Left or Up.
+func (d Type) IsCardinal() bool {
+	return d <= Up
+}
+
+// clockwiseOrder is the order of all the directions when rotating clockwise
+// starting from up, with clockwiseIndex being its inverse.
+var (
+	clockwiseOrder = [...]Type{Up, UpRight, Right, DownRight, Down, DownLeft, Left, UpLeft}
+	clockwiseIndex = [...]int{
+		Up: 0, UpRight: 1, Right: 2, DownRight: 3,
+		Down: 4, DownLeft: 5, Left: 6, UpLeft: 7,
+	}
+)
+
+// rotate45 returns the direction after rotating n times by 45 degrees in the
+// clockwise manner.
+func (d Type) rotate45(n int) Type {
+	return clockwiseOrder[(clockwiseIndex[d]+n)%len(clockwiseOrder)]
+}
+
 func (d Type) String() string {
 	switch d {
 	case Right:
@@ -62,6 +192,14 @@ func (d Type) String() string {
 		return "Down"
 	case Left:
 		return "Left"
+	case UpRight:
+		return "UpRight"
+	case DownRight:
+		return "DownRight"
+	case DownLeft:
+		return "DownLeft"
+	case UpLeft:
+		return "UpLeft"
 	default:
 		return "Up"
 	}
@@ -0,0 +1,75 @@
+package direction
+
+import (
+	"testing"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+)
+
+func TestFromRune(t *testing.T) {
+	for _, tc := range []struct {
+		runes    string
+		expected Type
+	}{
+		{"^UN", Up},
+		{"vDS", Down},
+		{"<LW", Left},
+		{">RE", Right},
+	} {
+		for _, r := range tc.runes {
+			d, err := FromRune(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d != tc.expected {
+				t.Errorf("FromRune(%q); expected: %v, actual: %v\n", r, tc.expected, d)
+			}
+		}
+	}
+
+	if _, err := FromArrow('U'); err == nil {
+		t.Error("FromArrow('U'); expected an error")
+	}
+	if _, err := FromRune('x'); err == nil {
+		t.Error("FromRune('x'); expected an error")
+	}
+}
+
+func TestDelta(t *testing.T) {
+	seen := make(map[geom.Point2D[int]]bool)
+	for d := Right; d <= UpLeft; d++ {
+		delta := d.Delta()
+		if delta == (geom.Point2D[int]{}) || seen[delta] {
+			t.Errorf("%v.Delta() = %v; expected a unique non-zero delta\n", d, delta)
+		}
+		seen[delta] = true
+
+		if actual, expected := d.Clockwise().Delta(), delta.Rotate90CW(); actual != expected {
+			t.Errorf("%v.Clockwise().Delta(); expected: %v, actual: %v\n", d, expected, actual)
+		}
+		if actual := d.Clockwise().CounterClockwise(); actual != d {
+			t.Errorf("%v.Clockwise().CounterClockwise(); expected: %v, actual: %v\n", d, d, actual)
+		}
+	}
+}
+
+func TestRotate45(t *testing.T) {
+	for d := Right; d <= UpLeft; d++ {
+		if actual, expected := d.Opposite().Delta(), d.Delta().Scale(-1); actual != expected {
+			t.Errorf("%v.Opposite().Delta(); expected: %v, actual: %v\n", d, expected, actual)
+		}
+		if actual := d.Clockwise45().Clockwise45(); actual != d.Clockwise() {
+			t.Errorf("%v.Clockwise45() twice; expected: %v, actual: %v\n", d, d.Clockwise(), actual)
+		}
+		if actual := d.Clockwise45().CounterClockwise45(); actual != d {
+			t.Errorf("%v.Clockwise45().CounterClockwise45(); expected: %v, actual: %v\n", d, d, actual)
+		}
+		if delta := d.Delta(); d.IsCardinal() != (delta.X == 0 || delta.Y == 0) {
+			t.Errorf("%v.IsCardinal(); unexpected for delta %v\n", d, delta)
+		}
+	}
+
+	if actual := Up.Clockwise45(); actual != UpRight {
+		t.Errorf("Up.Clockwise45(); expected: %v, actual: %v\n", UpRight, actual)
+	}
+}
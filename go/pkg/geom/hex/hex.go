@@ -0,0 +1,199 @@
+// Package hex implements a hexagonal grid using the axial coordinate system,
+// sometimes called "trapezoidal" or "oblique" or "skewed".
+//
+// The hexagons are "pointy topped", so every hex has a neighbor to the east
+// and the west, while the other four neighbors are to the north east, north
+// west, south east and south west. The R axis increases towards the south.
+//
+// For additional reference on these coordinate systems:
+// http://www.redblobgames.com/grids/hexagons/#coordinates
+package hex
+
+import (
+	"fmt"
+
+	"github.com/dhruvmanila/advent-of-code/go/util"
+)
+
+// Direction is one of the six directions to move from a hex to its neighbor.
+type Direction uint8
+
+const (
+	East Direction = iota
+	SouthEast
+	SouthWest
+	West
+	NorthWest
+	NorthEast
+)
+
+// Directions contains all the six directions in the clockwise order starting
+// from east.
+var Directions = [6]Direction{East, SouthEast, SouthWest, West, NorthWest, NorthEast}
+
+// directionOffsets is an array representing the offsets for a specific
+// direction from the referenced hex. The order is maintained as defined by
+// the Direction constants.
+var directionOffsets = [6]Hex{
+	{Q: 1, R: 0},  // East
+	{Q: 0, R: 1},  // SouthEast
+	{Q: -1, R: 1}, // SouthWest
+	{Q: -1, R: 0}, // West
+	{Q: 0, R: -1}, // NorthWest
+	{Q: 1, R: -1}, // NorthEast
+}
+
+// directionNames is a map from the abbreviated name of a direction to the
+// respective Direction.
+var directionNames = map[string]Direction{
+	"e":  East,
+	"se": SouthEast,
+	"sw": SouthWest,
+	"w":  West,
+	"nw": NorthWest,
+	"ne": NorthEast,
+}
+
+// ParseDirection returns the direction for the given abbreviated name which is
+// one of "e", "se", "sw", "w", "nw" or "ne".
+func ParseDirection(s string) (Direction, error) {
+	d, ok := directionNames[s]
+	if !ok {
+		return 0, fmt.Errorf("hex: invalid direction %q", s)
+	}
+	return d, nil
+}
+
+// ParseDirections parses a sequence of abbreviated direction names which are
+// not separated by any delimiter, like "esenee", into the respective directions.
+func ParseDirections(s string) ([]Direction, error) {
+	var directions []Direction
+	for i := 0; i < len(s); i++ {
+		name := s[i : i+1]
+		if (s[i] == 'n' || s[i] == 's') && i+1 < len(s) {
+			i++
+			name = s[i-1 : i+1]
+		}
+		d, err := ParseDirection(name)
+		if err != nil {
+			return nil, err
+		}
+		directions = append(directions, d)
+	}
+	return directions, nil
+}
+
+// Offset returns the hex offset to move in the receiver direction.
+func (d Direction) Offset() Hex {
+	return directionOffsets[d]
+}
+
+// Clockwise returns the direction rotated by 60 degrees in the clockwise
+// direction.
+func (d Direction) Clockwise() Direction {
+	return (d + 1) % 6
+}
+
+// CounterClockwise returns the direction rotated by 60 degrees in the counter
+// clockwise direction.
+func (d Direction) CounterClockwise() Direction {
+	return (d + 5) % 6
+}
+
+// Opposite returns the direction opposite to the receiver direction.
+func (d Direction) Opposite() Direction {
+	return (d + 3) % 6
+}
+
+func (d Direction) String() string {
+	switch d {
+	case East:
+		return "East"
+	case SouthEast:
+		return "SouthEast"
+	case SouthWest:
+		return "SouthWest"
+	case West:
+		return "West"
+	case NorthWest:
+		return "NorthWest"
+	default:
+		return "NorthEast"
+	}
+}
+
+// Hex describes a regular hexagon using the axial coordinates.
+type Hex struct {
+	Q int // x axis
+	R int // y axis
+}
+
+// New creates a new hex using the axial coordinates.
+func New(q, r int) Hex {
+	return Hex{Q: q, R: r}
+}
+
+// S returns the third coordinate of the hex in the cube coordinate system,
+// where q + r + s = 0.
+func (h Hex) S() int {
+	return -h.Q - h.R
+}
+
+// Add adds h to other, returning the new hex.
+func (h Hex) Add(other Hex) Hex {
+	return Hex{Q: h.Q + other.Q, R: h.R + other.R}
+}
+
+// Sub subtract other from h, returning the new hex.
+func (h Hex) Sub(other Hex) Hex {
+	return Hex{Q: h.Q - other.Q, R: h.R - other.R}
+}
+
+// Neighbor returns the neighboring hex for h in the given direction.
+func (h Hex) Neighbor(d Direction) Hex {
+	return h.Add(d.Offset())
+}
+
+// Neighbors returns all the six neighboring hexes for h in the order of
+// Directions.
+func (h Hex) Neighbors() []Hex {
+	neighbors := make([]Hex, 0, len(directionOffsets))
+	for _, offset := range directionOffsets {
+		neighbors = append(neighbors, h.Add(offset))
+	}
+	return neighbors
+}
+
+// Distance returns the minimum number of steps to move from h to other.
+func (h Hex) Distance(other Hex) int {
+	d := h.Sub(other)
+	return (util.Abs(d.Q) + util.Abs(d.R) + util.Abs(d.S())) / 2
+}
+
+// RotateClockwise rotates h by 60 degrees in the clockwise direction about the
+// origin, returning the new hex.
+func (h Hex) RotateClockwise() Hex {
+	return Hex{Q: -h.R, R: -h.S()}
+}
+
+// RotateCounterClockwise rotates h by 60 degrees in the counter clockwise
+// direction about the origin, returning the new hex.
+func (h Hex) RotateCounterClockwise() Hex {
+	return Hex{Q: -h.S(), R: -h.Q}
+}
+
+// RotateClockwiseAround rotates h by 60 degrees in the clockwise direction
+// about the given center hex, returning the new hex.
+func (h Hex) RotateClockwiseAround(center Hex) Hex {
+	return h.Sub(center).RotateClockwise().Add(center)
+}
+
+// RotateCounterClockwiseAround rotates h by 60 degrees in the counter
+// clockwise direction about the given center hex, returning the new hex.
+func (h Hex) RotateCounterClockwiseAround(center Hex) Hex {
+	return h.Sub(center).RotateCounterClockwise().Add(center)
+}
+
+func (h Hex) String() string {
+	return fmt.Sprintf("(%d, %d)", h.Q, h.R)
+}
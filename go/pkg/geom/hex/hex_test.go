@@ -0,0 +1,50 @@
+package hex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDirections(t *testing.T) {
+	directions, err := ParseDirections("esenwwsw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Direction{East, SouthEast, NorthWest, West, SouthWest}
+	if !reflect.DeepEqual(directions, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, directions)
+	}
+
+	if _, err := ParseDirections("ens"); err == nil {
+		t.Error("ParseDirections(\"ens\"); expected an error")
+	}
+}
+
+func TestHexDistance(t *testing.T) {
+	origin := New(0, 0)
+	for _, d := range Directions {
+		if dist := origin.Neighbor(d).Distance(origin); dist != 1 {
+			t.Errorf("distance to %v neighbor; expected: 1, actual: %d\n", d, dist)
+		}
+	}
+	if dist := New(3, -1).Distance(New(-2, 2)); dist != 5 {
+		t.Errorf("New(3, -1).Distance(New(-2, 2)); expected: 5, actual: %d\n", dist)
+	}
+}
+
+func TestHexRotate(t *testing.T) {
+	for _, d := range Directions {
+		if actual, expected := d.Offset().RotateClockwise(), d.Clockwise().Offset(); actual != expected {
+			t.Errorf("%v rotated clockwise; expected: %v, actual: %v\n", d, expected, actual)
+		}
+		if actual, expected := d.Offset().RotateCounterClockwise(), d.CounterClockwise().Offset(); actual != expected {
+			t.Errorf("%v rotated counter clockwise; expected: %v, actual: %v\n", d, expected, actual)
+		}
+	}
+
+	center := New(2, 1)
+	h := center.Neighbor(East)
+	if actual, expected := h.RotateClockwiseAround(center), center.Neighbor(SouthEast); actual != expected {
+		t.Errorf("rotate around center; expected: %v, actual: %v\n", expected, actual)
+	}
+}
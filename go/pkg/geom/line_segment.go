@@ -0,0 +1,194 @@
+package geom
+
+import (
+	"golang.org/x/exp/constraints"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/mathutil"
+	"github.com/dhruvmanila/advent-of-code/go/util"
+)
+
+// LineSegment2D represents a line segment between the two endpoints in a two
+// dimensional coordinate system. Both the endpoints are part of the segment.
+type LineSegment2D[T constraints.Signed] struct {
+	Start, End Point2D[T]
+}
+
+// NewLineSegment2D creates a new line segment between the given endpoints.
+func NewLineSegment2D[T constraints.Signed](start, end Point2D[T]) LineSegment2D[T] {
+	return LineSegment2D[T]{Start: start, End: end}
+}
+
+// IsHorizontal returns true if the line segment is parallel to the X axis.
+func (l LineSegment2D[T]) IsHorizontal() bool {
+	return l.Start.Y == l.End.Y
+}
+
+// IsVertical returns true if the line segment is parallel to the Y axis.
+func (l LineSegment2D[T]) IsVertical() bool {
+	return l.Start.X == l.End.X
+}
+
+// IsDiagonal returns true if the line segment is at 45 degrees to the axes,
+// that is, the slope is either 1 or -1.
+func (l LineSegment2D[T]) IsDiagonal() bool {
+	d := l.End.Sub(l.Start)
+	return d.X != 0 && util.Abs(d.X) == util.Abs(d.Y)
+}
+
+// Length returns the number of unit steps between the endpoints when moving
+// horizontally, vertically or diagonally. For horizontal and vertical
+// segments, this is the usual length of the segment.
+func (l LineSegment2D[T]) Length() T {
+	d := l.End.Sub(l.Start)
+	return max(util.Abs(d.X), util.Abs(d.Y))
+}
+
+// Contains returns true if the point p lies on the line segment.
+func (l LineSegment2D[T]) Contains(p Point2D[T]) bool {
+	d, dp := l.End.Sub(l.Start), p.Sub(l.Start)
+	if d.Cross(dp) != 0 {
+		return false
+	}
+	return min(l.Start.X, l.End.X) <= p.X && p.X <= max(l.Start.X, l.End.X) &&
+		min(l.Start.Y, l.End.Y) <= p.Y && p.Y <= max(l.Start.Y, l.End.Y)
+}
+
+// Points returns all the points with integer coordinates which lie on the line
+// segment, in order from the start to the end point. For horizontal, vertical
+// and diagonal segments, these are all the points at a unit step from one
+// another.
+//
+// For any other slope, only the points which lie exactly on the segment are
+// included. Use LinePoints to get an approximation of the line instead.
+func (l LineSegment2D[T]) Points() []Point2D[T] {
+	step, n := l.step()
+	points := make([]Point2D[T], 0, n+1)
+	for k, p := T(0), l.Start; k <= n; k, p = k+1, p.Add(step) {
+		points = append(points, p)
+	}
+	return points
+}
+
+// Intersection returns all the points with integer coordinates which lie on
+// both the line segments, in order from the start to the end point of l. This
+// is at most a single point unless the segments are collinear and overlapping.
+func (l LineSegment2D[T]) Intersection(other LineSegment2D[T]) []Point2D[T] {
+	step, n := l.step()
+	if n == 0 {
+		if other.Contains(l.Start) {
+			return []Point2D[T]{l.Start}
+		}
+		return nil
+	}
+
+	d1, d2 := l.End.Sub(l.Start), other.End.Sub(other.Start)
+	ds := other.Start.Sub(l.Start)
+	denom := d1.Cross(d2)
+
+	if denom == 0 {
+		// The segments are parallel, so they only intersect if they are
+		// collinear as well, in which case the points on l can be
+		// represented as l.Start + k*step for k in [0, n].
+		if ds.Cross(d1) != 0 {
+			return nil
+		}
+		k1, k2 := l.stepIndex(other.Start, step), l.stepIndex(other.End, step)
+		if k1[0]*k2[1] > k2[0]*k1[1] {
+			k1, k2 = k2, k1
+		}
+		// Round the range inwards to the nearest lattice points on l.
+		lo, hi := max(ceilDiv(k1[0], k1[1]), 0), min(floorDiv(k2[0], k2[1]), n)
+		var points []Point2D[T]
+		for k := lo; k <= hi; k++ {
+			points = append(points, l.Start.Add(step.Scale(k)))
+		}
+		return points
+	}
+
+	// The point of intersection is l.Start + t*d1 = other.Start + u*d2 where
+	// t = tn/denom and u = un/denom which must both be in [0, 1].
+	tn, un := ds.Cross(d2), ds.Cross(d1)
+	if denom < 0 {
+		denom, tn, un = -denom, -tn, -un
+	}
+	if tn < 0 || tn > denom || un < 0 || un > denom {
+		return nil
+	}
+	x, y := tn*d1.X, tn*d1.Y
+	if x%denom != 0 || y%denom != 0 {
+		return nil
+	}
+	return []Point2D[T]{l.Start.Add(Point2D[T]{X: x / denom, Y: y / denom})}
+}
+
+// step returns the smallest step between two consecutive lattice points on
+// the line segment and the number of such steps from the start to the end
+// point. If the segment is a single point, then the step is a zero vector.
+func (l LineSegment2D[T]) step() (Point2D[T], T) {
+	d := l.End.Sub(l.Start)
+	g := mathutil.GCD(d.X, d.Y)
+	if g == 0 {
+		return Point2D[T]{}, 0
+	}
+	return Point2D[T]{X: d.X / g, Y: d.Y / g}, g
+}
+
+// stepIndex returns the fraction k, as a numerator and a positive denominator,
+// such that p = l.Start + k*step where p is collinear with l.
+func (l LineSegment2D[T]) stepIndex(p Point2D[T], step Point2D[T]) [2]T {
+	dp := p.Sub(l.Start)
+	num, den := dp.X, step.X
+	if den == 0 {
+		num, den = dp.Y, step.Y
+	}
+	if den < 0 {
+		num, den = -num, -den
+	}
+	return [2]T{num, den}
+}
+
+// floorDiv returns a/b rounded towards negative infinity for a positive b.
+func floorDiv[T constraints.Signed](a, b T) T {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
+
+// ceilDiv returns a/b rounded towards positive infinity for a positive b.
+func ceilDiv[T constraints.Signed](a, b T) T {
+	q := a / b
+	if a%b != 0 && a > 0 {
+		q++
+	}
+	return q
+}
+
+// LinePoints returns the points on the grid which approximate the straight
+// line from a to b, both inclusive, using Bresenham's line algorithm. Unlike
+// LineSegment2D.Points, this works for any slope and every consecutive pair
+// of points are adjacent, including the diagonals.
+func LinePoints(a, b Point2D[int]) []Point2D[int] {
+	dx, dy := util.Abs(b.X-a.X), -util.Abs(b.Y-a.Y)
+	sx, sy := util.Signum(b.X-a.X), util.Signum(b.Y-a.Y)
+
+	points := make([]Point2D[int], 0, max(dx, -dy)+1)
+	err := dx + dy
+	for p := a; ; {
+		points = append(points, p)
+		if p == b {
+			break
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			p.X += sx
+		}
+		if e2 <= dx {
+			err += dx
+			p.Y += sy
+		}
+	}
+	return points
+}
@@ -0,0 +1,160 @@
+package geom
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLineSegment2DPoints(t *testing.T) {
+	testCases := []struct {
+		name     string
+		segment  LineSegment2D[int]
+		expected []Point2D[int]
+	}{
+		{
+			name:     "horizontal",
+			segment:  NewLineSegment2D(Point2D[int]{3, 4}, Point2D[int]{1, 4}),
+			expected: []Point2D[int]{{3, 4}, {2, 4}, {1, 4}},
+		},
+		{
+			name:     "vertical",
+			segment:  NewLineSegment2D(Point2D[int]{0, 0}, Point2D[int]{0, 2}),
+			expected: []Point2D[int]{{0, 0}, {0, 1}, {0, 2}},
+		},
+		{
+			name:     "diagonal",
+			segment:  NewLineSegment2D(Point2D[int]{9, 7}, Point2D[int]{7, 9}),
+			expected: []Point2D[int]{{9, 7}, {8, 8}, {7, 9}},
+		},
+		{
+			name:     "other slope",
+			segment:  NewLineSegment2D(Point2D[int]{0, 0}, Point2D[int]{4, 2}),
+			expected: []Point2D[int]{{0, 0}, {2, 1}, {4, 2}},
+		},
+		{
+			name:     "single point",
+			segment:  NewLineSegment2D(Point2D[int]{1, 1}, Point2D[int]{1, 1}),
+			expected: []Point2D[int]{{1, 1}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.segment.Points(); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLineSegment2DIntersection(t *testing.T) {
+	segment := NewLineSegment2D(Point2D[int]{0, 0}, Point2D[int]{6, 0})
+
+	testCases := []struct {
+		name     string
+		other    LineSegment2D[int]
+		expected []Point2D[int]
+	}{
+		{
+			name:     "crossing",
+			other:    NewLineSegment2D(Point2D[int]{2, -2}, Point2D[int]{2, 3}),
+			expected: []Point2D[int]{{2, 0}},
+		},
+		{
+			name:     "touching at endpoint",
+			other:    NewLineSegment2D(Point2D[int]{8, 2}, Point2D[int]{6, 0}),
+			expected: []Point2D[int]{{6, 0}},
+		},
+		{
+			name:     "crossing between lattice points",
+			other:    NewLineSegment2D(Point2D[int]{0, -1}, Point2D[int]{1, 1}),
+			expected: nil,
+		},
+		{
+			name:     "parallel",
+			other:    NewLineSegment2D(Point2D[int]{0, 1}, Point2D[int]{6, 1}),
+			expected: nil,
+		},
+		{
+			name:     "collinear overlapping",
+			other:    NewLineSegment2D(Point2D[int]{9, 0}, Point2D[int]{4, 0}),
+			expected: []Point2D[int]{{4, 0}, {5, 0}, {6, 0}},
+		},
+		{
+			name:     "collinear disjoint",
+			other:    NewLineSegment2D(Point2D[int]{-1, 0}, Point2D[int]{-3, 0}),
+			expected: nil,
+		},
+		{
+			name:     "not intersecting",
+			other:    NewLineSegment2D(Point2D[int]{7, 1}, Point2D[int]{9, -1}),
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := segment.Intersection(tc.other); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLineSegment2DOrientation(t *testing.T) {
+	l := NewLineSegment2D(Point2D[int]{1, 1}, Point2D[int]{4, -2})
+	if l.IsHorizontal() || l.IsVertical() || !l.IsDiagonal() {
+		t.Errorf("%v; expected only diagonal orientation\n", l)
+	}
+	if length := l.Length(); length != 3 {
+		t.Errorf("l.Length(); expected: 3, actual: %d\n", length)
+	}
+}
+
+func TestLinePoints(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a, b     Point2D[int]
+		expected []Point2D[int]
+	}{
+		{
+			name:     "single point",
+			a:        Point2D[int]{2, 2},
+			b:        Point2D[int]{2, 2},
+			expected: []Point2D[int]{{2, 2}},
+		},
+		{
+			name:     "vertical",
+			a:        Point2D[int]{1, 3},
+			b:        Point2D[int]{1, 0},
+			expected: []Point2D[int]{{1, 3}, {1, 2}, {1, 1}, {1, 0}},
+		},
+		{
+			name:     "diagonal",
+			a:        Point2D[int]{0, 0},
+			b:        Point2D[int]{-2, 2},
+			expected: []Point2D[int]{{0, 0}, {-1, 1}, {-2, 2}},
+		},
+		{
+			name:     "shallow slope",
+			a:        Point2D[int]{0, 0},
+			b:        Point2D[int]{5, 2},
+			expected: []Point2D[int]{{0, 0}, {1, 0}, {2, 1}, {3, 1}, {4, 2}, {5, 2}},
+		},
+		{
+			name:     "steep slope",
+			a:        Point2D[int]{0, 0},
+			b:        Point2D[int]{-1, -3},
+			expected: []Point2D[int]{{0, 0}, {0, -1}, {-1, -2}, {-1, -3}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := LinePoints(tc.a, tc.b)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package geom
+
+import (
+	"iter"
+
+	"github.com/dhruvmanila/advent-of-code/go/util"
+)
+
+// ManhattanBall represents all the points which are at most Radius distance
+// away from the Center using the manhattan distance. In other words, it's a
+// diamond shape with the corners at Radius distance from the Center along the
+// X and Y axis.
+type ManhattanBall struct {
+	Center Point2D[int]
+	Radius int
+}
+
+// NewManhattanBall creates a new manhattan ball with the given center and
+// radius.
+func NewManhattanBall(center Point2D[int], radius int) ManhattanBall {
+	return ManhattanBall{Center: center, Radius: radius}
+}
+
+// Contains returns true if the point p is inside or on the perimeter of the
+// ball.
+func (b ManhattanBall) Contains(p Point2D[int]) bool {
+	return b.Center.ManhattanDistance(p) <= b.Radius
+}
+
+// RowSlice returns the range of X coordinates, both inclusive, covered by the
+// ball at the given Y coordinate. The ok value is false if the ball doesn't
+// cover any point at y.
+func (b ManhattanBall) RowSlice(y int) (minx, maxx int, ok bool) {
+	width := b.Radius - util.Abs(b.Center.Y-y)
+	if width < 0 {
+		return 0, 0, false
+	}
+	return b.Center.X - width, b.Center.X + width, true
+}
+
+// Perimeter returns an iterator over the points which are exactly Radius
+// distance away from the Center. Each point is yielded exactly once, going
+// from the left most corner to the right most corner.
+func (b ManhattanBall) Perimeter() iter.Seq[Point2D[int]] {
+	return func(yield func(Point2D[int]) bool) {
+		for dx := -b.Radius; dx <= b.Radius; dx++ {
+			dy := b.Radius - util.Abs(dx)
+			if !yield(Point2D[int]{X: b.Center.X + dx, Y: b.Center.Y - dy}) {
+				return
+			}
+			if dy != 0 && !yield(Point2D[int]{X: b.Center.X + dx, Y: b.Center.Y + dy}) {
+				return
+			}
+		}
+	}
+}
+
+// BoundingBox returns the smallest bounding box which contains the ball.
+func (b ManhattanBall) BoundingBox() *BoundingBox2D {
+	return NewBoundingBox2D(
+		b.Center.X-b.Radius, b.Center.X+b.Radius,
+		b.Center.Y-b.Radius, b.Center.Y+b.Radius,
+	)
+}
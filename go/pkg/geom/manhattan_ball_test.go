@@ -0,0 +1,56 @@
+package geom
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestManhattanBallPerimeter(t *testing.T) {
+	for _, radius := range []int{0, 1, 4} {
+		b := NewManhattanBall(Point2D[int]{X: 2, Y: -3}, radius)
+		points := slices.Collect(b.Perimeter())
+
+		expected := max(4*radius, 1)
+		if len(points) != expected {
+			t.Errorf("radius %d; expected %d points, actual: %d\n", radius, expected, len(points))
+		}
+		seen := make(map[Point2D[int]]bool)
+		for _, p := range points {
+			if seen[p] || b.Center.ManhattanDistance(p) != radius {
+				t.Errorf("radius %d; unexpected perimeter point %v\n", radius, p)
+			}
+			seen[p] = true
+		}
+	}
+}
+
+func TestManhattanBallRowSlice(t *testing.T) {
+	b := NewManhattanBall(Point2D[int]{X: 8, Y: 7}, 9)
+
+	testCases := []struct {
+		y          int
+		minx, maxx int
+		ok         bool
+	}{
+		{y: 7, minx: -1, maxx: 17, ok: true},
+		{y: 10, minx: 2, maxx: 14, ok: true},
+		{y: -2, minx: 8, maxx: 8, ok: true},
+		{y: 17, ok: false},
+	}
+
+	for _, tc := range testCases {
+		minx, maxx, ok := b.RowSlice(tc.y)
+		if minx != tc.minx || maxx != tc.maxx || ok != tc.ok {
+			t.Errorf(
+				"RowSlice(%d); expected: (%d, %d, %t), actual: (%d, %d, %t)\n",
+				tc.y, tc.minx, tc.maxx, tc.ok, minx, maxx, ok,
+			)
+		}
+		for x := minx - 1; ok && x <= maxx+1; x++ {
+			inside := x >= minx && x <= maxx
+			if contains := b.Contains(Point2D[int]{X: x, Y: tc.y}); contains != inside {
+				t.Errorf("Contains(%d, %d); expected: %t, actual: %t\n", x, tc.y, inside, contains)
+			}
+		}
+	}
+}
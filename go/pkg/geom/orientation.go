@@ -0,0 +1,92 @@
+package geom
+
+// Orientation3D is a rotation matrix for one of the 24 proper rotations of a
+// cube, i.e., the orientations in which an object can face any of the six
+// directions along the X, Y and Z axis, with any of the four directions
+// being up. Each row and column contains exactly one non-zero entry which is
+// either 1 or -1, and the determinant of the matrix is 1.
+type Orientation3D [3][3]int
+
+// Identity3D is the orientation which leaves a point unchanged.
+var Identity3D = Orientation3D{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+
+// Orientations3D contains all the 24 proper rotations in 3D. The first one is
+// always Identity3D.
+var Orientations3D = generateOrientations3D()
+
+// generateOrientations3D generates all the signed permutation matrices with
+// the determinant 1.
+func generateOrientations3D() [24]Orientation3D {
+	var orientations [24]Orientation3D
+	permutations := [6][3]int{
+		{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0},
+	}
+	n := 0
+	for _, perm := range permutations {
+		for signs := 0; signs < 8; signs++ {
+			var o Orientation3D
+			for row, col := range perm {
+				o[row][col] = 1
+				if signs&(1<<row) != 0 {
+					o[row][col] = -1
+				}
+			}
+			if o.determinant() == 1 {
+				orientations[n] = o
+				n++
+			}
+		}
+	}
+	return orientations
+}
+
+// Apply rotates the point p using the orientation o, returning the new point.
+func (o Orientation3D) Apply(p Point3D[int]) Point3D[int] {
+	return Point3D[int]{
+		X: o[0][0]*p.X + o[0][1]*p.Y + o[0][2]*p.Z,
+		Y: o[1][0]*p.X + o[1][1]*p.Y + o[1][2]*p.Z,
+		Z: o[2][0]*p.X + o[2][1]*p.Y + o[2][2]*p.Z,
+	}
+}
+
+// ApplyAll rotates all the points using the orientation o, returning a new
+// slice of points in the same order.
+func (o Orientation3D) ApplyAll(points []Point3D[int]) []Point3D[int] {
+	rotated := make([]Point3D[int], 0, len(points))
+	for _, p := range points {
+		rotated = append(rotated, o.Apply(p))
+	}
+	return rotated
+}
+
+// Compose returns the orientation equivalent to first applying other and
+// then o.
+func (o Orientation3D) Compose(other Orientation3D) Orientation3D {
+	var result Orientation3D
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				result[i][j] += o[i][k] * other[k][j]
+			}
+		}
+	}
+	return result
+}
+
+// Inverse returns the orientation which undoes o. For a rotation matrix, this
+// is the same as its transpose.
+func (o Orientation3D) Inverse() Orientation3D {
+	var result Orientation3D
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			result[i][j] = o[j][i]
+		}
+	}
+	return result
+}
+
+func (o Orientation3D) determinant() int {
+	return o[0][0]*(o[1][1]*o[2][2]-o[1][2]*o[2][1]) -
+		o[0][1]*(o[1][0]*o[2][2]-o[1][2]*o[2][0]) +
+		o[0][2]*(o[1][0]*o[2][1]-o[1][1]*o[2][0])
+}
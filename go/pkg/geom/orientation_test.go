@@ -0,0 +1,44 @@
+package geom
+
+import "testing"
+
+func TestOrientations3D(t *testing.T) {
+	if Orientations3D[0] != Identity3D {
+		t.Errorf("first orientation; expected: %v, actual: %v\n", Identity3D, Orientations3D[0])
+	}
+
+	p := Point3D[int]{X: 1, Y: 2, Z: 3}
+	seen := make(map[Point3D[int]]bool)
+	for _, o := range Orientations3D {
+		rotated := o.Apply(p)
+		if seen[rotated] {
+			t.Errorf("duplicate rotation %v\n", rotated)
+		}
+		seen[rotated] = true
+
+		if actual := o.Inverse().Apply(rotated); actual != p {
+			t.Errorf("inverse rotation; expected: %v, actual: %v\n", p, actual)
+		}
+		if actual := o.Compose(o.Inverse()); actual != Identity3D {
+			t.Errorf("compose with inverse; expected: %v, actual: %v\n", Identity3D, actual)
+		}
+	}
+	if len(seen) != 24 {
+		t.Errorf("unique rotations; expected: 24, actual: %d\n", len(seen))
+	}
+}
+
+func TestOrientation3DApplyAll(t *testing.T) {
+	// Rotation by 90 degrees about the Z axis.
+	o := Orientation3D{{0, -1, 0}, {1, 0, 0}, {0, 0, 1}}
+	points := []Point3D[int]{{1, 0, 0}, {0, 1, 5}}
+	expected := []Point3D[int]{{0, 1, 0}, {-1, 0, 5}}
+
+	actual := o.ApplyAll(points)
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+			break
+		}
+	}
+}
@@ -1,10 +1,13 @@
 package geom
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 
 	"golang.org/x/exp/constraints"
 
+	"github.com/dhruvmanila/advent-of-code/go/pkg/mathutil"
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
 
@@ -18,6 +21,21 @@ var Directions2D = [4]Point2D[int]{
 	{-1, 0}, // LEFT
 }
 
+// AllDirections2D is an array of points corresponding to the difference to
+// move in a certain direction in 2D including the diagonals. The order of the
+// points is clockwise starting from up, i.e., UP, UP-RIGHT, RIGHT, DOWN-RIGHT,
+// DOWN, DOWN-LEFT, LEFT, UP-LEFT.
+var AllDirections2D = [8]Point2D[int]{
+	{0, -1},  // UP
+	{1, -1},  // UP-RIGHT
+	{1, 0},   // RIGHT
+	{1, 1},   // DOWN-RIGHT
+	{0, 1},   // DOWN
+	{-1, 1},  // DOWN-LEFT
+	{-1, 0},  // LEFT
+	{-1, -1}, // UP-LEFT
+}
+
 // Directions3D is an array of points corresponding to the difference to
 // move in a certain direction in 3D. The order of the points is clockwise
 // starting from up, and then front and back (z-axis), i.e., UP, RIGHT, DOWN,
@@ -31,6 +49,12 @@ var Directions3D = [6]Point3D[int]{
 	{0, 0, -1}, // BACK
 }
 
+// SortPoints sorts the points in ascending order as defined by the Compare
+// method of the point type, i.e., the reading order for Point2D.
+func SortPoints[P interface{ Compare(P) int }](points []P) {
+	slices.SortFunc(points, P.Compare)
+}
+
 // Point2D represents a 2 dimensional point in the coordinate system.
 type Point2D[T constraints.Signed] struct {
 	X, Y T
@@ -50,6 +74,84 @@ func (p Point2D[T]) Sub(other Point2D[T]) Point2D[T] {
 	return p
 }
 
+// Scale multiplies both the coordinates of p by k, returning the new point.
+func (p Point2D[T]) Scale(k T) Point2D[T] {
+	p.X *= k
+	p.Y *= k
+	return p
+}
+
+// Dot returns the dot product of p and other treated as vectors.
+func (p Point2D[T]) Dot(other Point2D[T]) T {
+	return p.X*other.X + p.Y*other.Y
+}
+
+// Cross returns the Z component of the cross product of p and other treated
+// as vectors. This is positive if other is clockwise from p, negative if it
+// is counter clockwise and zero if they are collinear, as per the orientation
+// described in Rotate90CW.
+func (p Point2D[T]) Cross(other Point2D[T]) T {
+	return p.X*other.Y - p.Y*other.X
+}
+
+// Norm1 returns the L1 norm of p treated as a vector, which is the manhattan
+// distance from the origin.
+func (p Point2D[T]) Norm1() T {
+	return util.Abs(p.X) + util.Abs(p.Y)
+}
+
+// NormInf returns the L-infinity norm of p treated as a vector, which is the
+// chebyshev distance from the origin.
+func (p Point2D[T]) NormInf() T {
+	return max(util.Abs(p.X), util.Abs(p.Y))
+}
+
+// Signum returns the point with each coordinate of p replaced by its sign,
+// i.e., -1, 0 or 1. For a difference between two points, this is the single
+// step to move towards the target including the diagonals.
+func (p Point2D[T]) Signum() Point2D[T] {
+	return Point2D[T]{X: util.Signum(p.X), Y: util.Signum(p.Y)}
+}
+
+// Unit returns the smallest vector with integer coordinates in the same
+// direction as p, i.e., p divided by the greatest common divisor of its
+// coordinates. It returns the zero point if p is the origin.
+func (p Point2D[T]) Unit() Point2D[T] {
+	g := mathutil.GCD(p.X, p.Y)
+	if g == 0 {
+		return p
+	}
+	return Point2D[T]{X: p.X / g, Y: p.Y / g}
+}
+
+// Rotate90CW rotates p by 90 degrees in the clockwise direction about the
+// origin, returning the new point.
+//
+// The orientation is as per the coordinate system used by Directions2D where
+// the Y coordinate increases downwards, so UP is rotated to RIGHT. If the Y
+// coordinate increases upwards instead, this is a counter clockwise rotation.
+func (p Point2D[T]) Rotate90CW() Point2D[T] {
+	return Point2D[T]{X: -p.Y, Y: p.X}
+}
+
+// Rotate90CCW rotates p by 90 degrees in the counter clockwise direction about
+// the origin, returning the new point. See Rotate90CW for the orientation.
+func (p Point2D[T]) Rotate90CCW() Point2D[T] {
+	return Point2D[T]{X: p.Y, Y: -p.X}
+}
+
+// Rotate90CWAround rotates p by 90 degrees in the clockwise direction about
+// the given pivot point, returning the new point.
+func (p Point2D[T]) Rotate90CWAround(pivot Point2D[T]) Point2D[T] {
+	return p.Sub(pivot).Rotate90CW().Add(pivot)
+}
+
+// Rotate90CCWAround rotates p by 90 degrees in the counter clockwise direction
+// about the given pivot point, returning the new point.
+func (p Point2D[T]) Rotate90CCWAround(pivot Point2D[T]) Point2D[T] {
+	return p.Sub(pivot).Rotate90CCW().Add(pivot)
+}
+
 // Equal returns true if p and other are the same point.
 func (p Point2D[T]) Equal(other Point2D[T]) bool {
 	return p.X == other.X && p.Y == other.Y
@@ -60,19 +162,86 @@ func (p Point2D[T]) ManhattanDistance(other Point2D[T]) T {
 	return util.Abs(p.X-other.X) + util.Abs(p.Y-other.Y)
 }
 
-// Neighbors returns the neighboring points for p. These are the 6 directions
-// corresponding to +ve and -ve X, Y and Z axis.
+// ChebyshevDistance returns the chebyshev distance between p and other, which
+// is the number of steps to move from p to other if the diagonal moves are
+// allowed. Two points are adjacent, including the diagonals, if this is 1.
+func (p Point2D[T]) ChebyshevDistance(other Point2D[T]) T {
+	return p.Sub(other).NormInf()
+}
+
+// EuclideanDistanceSq returns the square of the euclidean distance between p
+// and other. The square is returned to keep the result exact.
+func (p Point2D[T]) EuclideanDistanceSq(other Point2D[T]) T {
+	d := p.Sub(other)
+	return d.Dot(d)
+}
+
+// Neighbors returns the neighboring points for p. These are the 4 directions
+// corresponding to +ve and -ve X and Y axis in the order of Directions2D.
 func (p Point2D[T]) Neighbors() []Point2D[T] {
-	neighbors := make([]Point2D[T], 0, len(Directions2D))
-	for _, direction := range Directions2D {
-		neighbors = append(neighbors, Point2D[T]{
+	return p.neighbors(Directions2D[:], nil)
+}
+
+// Neighbors8 returns the neighboring points for p including the diagonals in
+// the order of AllDirections2D.
+func (p Point2D[T]) Neighbors8() []Point2D[T] {
+	return p.neighbors(AllDirections2D[:], nil)
+}
+
+// NeighborsIn is similar to Neighbors but only includes the points which are
+// contained in the given bounding box.
+func (p Point2D[T]) NeighborsIn(bbox *BoundingBox2D) []Point2D[T] {
+	return p.neighbors(Directions2D[:], bbox)
+}
+
+// Neighbors8In is similar to Neighbors8 but only includes the points which are
+// contained in the given bounding box.
+func (p Point2D[T]) Neighbors8In(bbox *BoundingBox2D) []Point2D[T] {
+	return p.neighbors(AllDirections2D[:], bbox)
+}
+
+// neighbors returns the points after moving p in each of the given directions,
+// skipping the ones outside bbox if it's non-nil.
+func (p Point2D[T]) neighbors(directions []Point2D[int], bbox *BoundingBox2D) []Point2D[T] {
+	neighbors := make([]Point2D[T], 0, len(directions))
+	for _, direction := range directions {
+		n := Point2D[T]{
 			X: p.X + T(direction.X),
-			Y: p.X + T(direction.Y),
-		})
+			Y: p.Y + T(direction.Y),
+		}
+		if bbox != nil && !bbox.Contains(int(n.X), int(n.Y)) {
+			continue
+		}
+		neighbors = append(neighbors, n)
 	}
 	return neighbors
 }
 
+// Compare returns -1, 0 or 1 depending on whether p comes before, is the
+// same as or comes after other in the reading order. This is the row major
+// order where the points are compared by Y first and then by X, which is top
+// to bottom and left to right as per the Directions2D coordinate system.
+func (p Point2D[T]) Compare(other Point2D[T]) int {
+	if c := cmp.Compare(p.Y, other.Y); c != 0 {
+		return c
+	}
+	return cmp.Compare(p.X, other.X)
+}
+
+// Less returns true if p comes before other in the reading order.
+func (p Point2D[T]) Less(other Point2D[T]) bool {
+	return p.Compare(other) < 0
+}
+
+// CompareXY is similar to Compare, except the points are compared by X first
+// and then by Y, i.e., the column major order.
+func (p Point2D[T]) CompareXY(other Point2D[T]) int {
+	if c := cmp.Compare(p.X, other.X); c != 0 {
+		return c
+	}
+	return cmp.Compare(p.Y, other.Y)
+}
+
 func (p Point2D[T]) String() string {
 	return fmt.Sprintf("(%d, %d)", p.X, p.Y)
 }
@@ -98,6 +267,57 @@ func (p Point3D[T]) Sub(other Point3D[T]) Point3D[T] {
 	return p
 }
 
+// Scale multiplies all the coordinates of p by k, returning the new point.
+func (p Point3D[T]) Scale(k T) Point3D[T] {
+	p.X *= k
+	p.Y *= k
+	p.Z *= k
+	return p
+}
+
+// Dot returns the dot product of p and other treated as vectors.
+func (p Point3D[T]) Dot(other Point3D[T]) T {
+	return p.X*other.X + p.Y*other.Y + p.Z*other.Z
+}
+
+// Cross returns the cross product of p and other treated as vectors, which is
+// perpendicular to both of them.
+func (p Point3D[T]) Cross(other Point3D[T]) Point3D[T] {
+	return Point3D[T]{
+		X: p.Y*other.Z - p.Z*other.Y,
+		Y: p.Z*other.X - p.X*other.Z,
+		Z: p.X*other.Y - p.Y*other.X,
+	}
+}
+
+// Norm1 returns the L1 norm of p treated as a vector, which is the manhattan
+// distance from the origin.
+func (p Point3D[T]) Norm1() T {
+	return util.Abs(p.X) + util.Abs(p.Y) + util.Abs(p.Z)
+}
+
+// NormInf returns the L-infinity norm of p treated as a vector, which is the
+// chebyshev distance from the origin.
+func (p Point3D[T]) NormInf() T {
+	return max(util.Abs(p.X), util.Abs(p.Y), util.Abs(p.Z))
+}
+
+// Signum returns the point with each coordinate of p replaced by its sign,
+// i.e., -1, 0 or 1.
+func (p Point3D[T]) Signum() Point3D[T] {
+	return Point3D[T]{X: util.Signum(p.X), Y: util.Signum(p.Y), Z: util.Signum(p.Z)}
+}
+
+// Unit returns the smallest vector with integer coordinates in the same
+// direction as p. It returns the zero point if p is the origin.
+func (p Point3D[T]) Unit() Point3D[T] {
+	g := mathutil.GCD(p.X, p.Y, p.Z)
+	if g == 0 {
+		return p
+	}
+	return Point3D[T]{X: p.X / g, Y: p.Y / g, Z: p.Z / g}
+}
+
 // Equal returns true if p and other are the same point.
 func (p Point3D[T]) Equal(other Point3D[T]) bool {
 	return p.X == other.X && p.Y == other.Y && p.Z == other.Z
@@ -108,6 +328,20 @@ func (p Point3D[T]) ManhattanDistance(other Point3D[T]) T {
 	return util.Abs(p.X-other.X) + util.Abs(p.Y-other.Y) + util.Abs(p.Z-other.Z)
 }
 
+// ChebyshevDistance returns the chebyshev distance between p and other, which
+// is the number of steps to move from p to other if the diagonal moves are
+// allowed. Two points are adjacent, including the diagonals, if this is 1.
+func (p Point3D[T]) ChebyshevDistance(other Point3D[T]) T {
+	return p.Sub(other).NormInf()
+}
+
+// EuclideanDistanceSq returns the square of the euclidean distance between p
+// and other. The square is returned to keep the result exact.
+func (p Point3D[T]) EuclideanDistanceSq(other Point3D[T]) T {
+	d := p.Sub(other)
+	return d.Dot(d)
+}
+
 // Neighbors returns the neighboring points for p. These are the 6 directions
 // corresponding to +ve and -ve X, Y and Z axis.
 func (p Point3D[T]) Neighbors() []Point3D[T] {
@@ -122,6 +356,25 @@ func (p Point3D[T]) Neighbors() []Point3D[T] {
 	return neighbors
 }
 
+// Compare returns -1, 0 or 1 depending on whether p comes before, is the
+// same as or comes after other. The points are compared by Z first, then by
+// Y and then by X, so that the points in every layer along the Z axis are in
+// the reading order.
+func (p Point3D[T]) Compare(other Point3D[T]) int {
+	if c := cmp.Compare(p.Z, other.Z); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(p.Y, other.Y); c != 0 {
+		return c
+	}
+	return cmp.Compare(p.X, other.X)
+}
+
+// Less returns true if p comes before other as per Compare.
+func (p Point3D[T]) Less(other Point3D[T]) bool {
+	return p.Compare(other) < 0
+}
+
 func (p Point3D[T]) String() string {
 	return fmt.Sprintf("(%d, %d, %d)", p.X, p.Y, p.Z)
 }
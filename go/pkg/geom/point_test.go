@@ -0,0 +1,168 @@
+package geom
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPoint2DRotate(t *testing.T) {
+	p := Point2D[int]{X: 3, Y: -1}
+
+	testCases := []struct {
+		name     string
+		actual   Point2D[int]
+		expected Point2D[int]
+	}{
+		{name: "clockwise", actual: p.Rotate90CW(), expected: Point2D[int]{1, 3}},
+		{name: "counter clockwise", actual: p.Rotate90CCW(), expected: Point2D[int]{-1, -3}},
+		{name: "up to right", actual: Directions2D[0].Rotate90CW(), expected: Directions2D[1]},
+		{name: "full rotation", actual: p.Rotate90CW().Rotate90CW().Rotate90CW().Rotate90CW(), expected: p},
+		{name: "clockwise around pivot", actual: p.Rotate90CWAround(Point2D[int]{1, 1}), expected: Point2D[int]{3, 3}},
+		{name: "counter clockwise around pivot", actual: p.Rotate90CCWAround(Point2D[int]{1, 1}), expected: Point2D[int]{-1, -1}},
+		{name: "scale", actual: p.Scale(-2), expected: Point2D[int]{-6, 2}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.actual != tc.expected {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, tc.actual)
+			}
+		})
+	}
+}
+
+func TestPoint2DProducts(t *testing.T) {
+	a, b := Point2D[int]{X: 2, Y: 3}, Point2D[int]{X: -1, Y: 4}
+
+	if dot := a.Dot(b); dot != 10 {
+		t.Errorf("a.Dot(b); expected: 10, actual: %d\n", dot)
+	}
+	if cross := a.Cross(b); cross != 11 {
+		t.Errorf("a.Cross(b); expected: 11, actual: %d\n", cross)
+	}
+	if cross := a.Cross(a.Rotate90CW()); cross <= 0 {
+		t.Errorf("a.Cross(a.Rotate90CW()); expected positive, actual: %d\n", cross)
+	}
+}
+
+func TestPoint2DNeighbors(t *testing.T) {
+	p := Point2D[int]{X: 0, Y: 2}
+	bbox := NewBoundingBox2D(0, 3, 0, 2)
+
+	testCases := []struct {
+		name     string
+		actual   []Point2D[int]
+		expected []Point2D[int]
+	}{
+		{
+			name:     "neighbors",
+			actual:   p.Neighbors(),
+			expected: []Point2D[int]{{0, 1}, {1, 2}, {0, 3}, {-1, 2}},
+		},
+		{
+			name:     "neighbors8",
+			actual:   p.Neighbors8(),
+			expected: []Point2D[int]{{0, 1}, {1, 1}, {1, 2}, {1, 3}, {0, 3}, {-1, 3}, {-1, 2}, {-1, 1}},
+		},
+		{
+			name:     "neighbors in bbox",
+			actual:   p.NeighborsIn(bbox),
+			expected: []Point2D[int]{{0, 1}, {1, 2}},
+		},
+		{
+			name:     "neighbors8 in bbox",
+			actual:   p.Neighbors8In(bbox),
+			expected: []Point2D[int]{{0, 1}, {1, 1}, {1, 2}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, tc.actual)
+			}
+		})
+	}
+}
+
+func TestPointVectorMath(t *testing.T) {
+	p2 := Point2D[int]{X: -6, Y: 4}
+	p3 := Point3D[int]{X: 4, Y: 0, Z: -6}
+
+	testCases := []struct {
+		name     string
+		actual   any
+		expected any
+	}{
+		{name: "2d norm1", actual: p2.Norm1(), expected: 10},
+		{name: "2d norm inf", actual: p2.NormInf(), expected: 6},
+		{name: "2d signum", actual: p2.Signum(), expected: Point2D[int]{-1, 1}},
+		{name: "2d unit", actual: p2.Unit(), expected: Point2D[int]{-3, 2}},
+		{name: "2d unit origin", actual: Point2D[int]{}.Unit(), expected: Point2D[int]{}},
+		{name: "3d scale", actual: p3.Scale(2), expected: Point3D[int]{8, 0, -12}},
+		{name: "3d dot", actual: p3.Dot(Point3D[int]{1, 2, 3}), expected: -14},
+		{name: "3d cross", actual: Point3D[int]{1, 0, 0}.Cross(Point3D[int]{0, 1, 0}), expected: Point3D[int]{0, 0, 1}},
+		{name: "3d norm1", actual: p3.Norm1(), expected: 10},
+		{name: "3d norm inf", actual: p3.NormInf(), expected: 6},
+		{name: "3d signum", actual: p3.Signum(), expected: Point3D[int]{1, 0, -1}},
+		{name: "3d unit", actual: p3.Unit(), expected: Point3D[int]{2, 0, -3}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.actual != tc.expected {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, tc.actual)
+			}
+		})
+	}
+}
+
+func TestSortPoints(t *testing.T) {
+	points2D := []Point2D[int]{{2, 1}, {0, 3}, {-1, 1}, {5, 0}, {2, 1}}
+	SortPoints(points2D)
+	expected2D := []Point2D[int]{{5, 0}, {-1, 1}, {2, 1}, {2, 1}, {0, 3}}
+	if !reflect.DeepEqual(points2D, expected2D) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected2D, points2D)
+	}
+
+	points3D := []Point3D[int]{{0, 0, 1}, {1, 0, 0}, {0, 1, 0}, {0, 0, 0}}
+	SortPoints(points3D)
+	expected3D := []Point3D[int]{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	if !reflect.DeepEqual(points3D, expected3D) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected3D, points3D)
+	}
+
+	a, b := Point2D[int]{X: 1, Y: 5}, Point2D[int]{X: 2, Y: 0}
+	if !b.Less(a) || a.Less(b) || a.Less(a) {
+		t.Errorf("%v.Less(%v); expected reading order\n", b, a)
+	}
+	if c := a.CompareXY(b); c != -1 {
+		t.Errorf("%v.CompareXY(%v); expected: -1, actual: %d\n", a, b, c)
+	}
+}
+
+func TestPointDistances(t *testing.T) {
+	a2, b2 := Point2D[int]{X: 1, Y: -2}, Point2D[int]{X: -2, Y: 2}
+	a3, b3 := Point3D[int]{X: 1, Y: 2, Z: 3}, Point3D[int]{X: 2, Y: 4, Z: -1}
+
+	testCases := []struct {
+		name     string
+		actual   int
+		expected int
+	}{
+		{name: "2d manhattan", actual: a2.ManhattanDistance(b2), expected: 7},
+		{name: "2d chebyshev", actual: a2.ChebyshevDistance(b2), expected: 4},
+		{name: "2d chebyshev diagonal", actual: a2.ChebyshevDistance(a2.Add(Point2D[int]{1, 1})), expected: 1},
+		{name: "2d euclidean squared", actual: a2.EuclideanDistanceSq(b2), expected: 25},
+		{name: "3d chebyshev", actual: a3.ChebyshevDistance(b3), expected: 4},
+		{name: "3d euclidean squared", actual: a3.EuclideanDistanceSq(b3), expected: 21},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.actual != tc.expected {
+				t.Errorf("\nexpected: %d\nactual: %d\n", tc.expected, tc.actual)
+			}
+		})
+	}
+}
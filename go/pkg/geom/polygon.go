@@ -0,0 +1,116 @@
+package geom
+
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/mathutil"
+	"github.com/dhruvmanila/advent-of-code/go/util"
+)
+
+// Polygon2D represents a simple polygon in a two dimensional coordinate system.
+// The vertices are in order, either clockwise or counter clockwise, and the
+// last vertex is implicitly connected to the first one.
+type Polygon2D[T constraints.Signed] struct {
+	Vertices []Point2D[T]
+}
+
+// NewPolygon2D creates a new polygon from the given vertices. The first vertex
+// should not be repeated at the end.
+func NewPolygon2D[T constraints.Signed](vertices ...Point2D[T]) *Polygon2D[T] {
+	return &Polygon2D[T]{Vertices: vertices}
+}
+
+// TwiceArea returns twice the area of the polygon using the Shoelace formula.
+// The area of a polygon with integer vertices is always a multiple of one
+// half, so this is exact unlike Area.
+func (p *Polygon2D[T]) TwiceArea() T {
+	var area T
+	for i, a := range p.Vertices {
+		b := p.Vertices[(i+1)%len(p.Vertices)]
+		area += a.X*b.Y - b.X*a.Y
+	}
+	return util.Abs(area)
+}
+
+// Area returns the area of the polygon.
+func (p *Polygon2D[T]) Area() float64 {
+	return float64(p.TwiceArea()) / 2
+}
+
+// Perimeter returns the total length of all the edges of the polygon.
+func (p *Polygon2D[T]) Perimeter() float64 {
+	var perimeter float64
+	for i, a := range p.Vertices {
+		d := p.Vertices[(i+1)%len(p.Vertices)].Sub(a)
+		perimeter += math.Hypot(float64(d.X), float64(d.Y))
+	}
+	return perimeter
+}
+
+// BoundaryLatticePoints returns the number of points with integer coordinates
+// which lie on the edges of the polygon. For a polygon with only horizontal
+// and vertical edges, this is the same as the perimeter.
+func (p *Polygon2D[T]) BoundaryLatticePoints() T {
+	var count T
+	for i, a := range p.Vertices {
+		d := p.Vertices[(i+1)%len(p.Vertices)].Sub(a)
+		count += mathutil.GCD(d.X, d.Y)
+	}
+	return count
+}
+
+// InteriorLatticePoints returns the number of points with integer coordinates
+// which lie strictly inside the polygon. This uses Pick's theorem which
+// states that A = I + B/2 - 1 where A is the area, I is the number of
+// interior points and B is the number of boundary points.
+//
+// For a polygon drawn on a grid where every cell is a point, the total
+// number of cells covered by the polygon, including the boundary, is the
+// sum of InteriorLatticePoints and BoundaryLatticePoints.
+func (p *Polygon2D[T]) InteriorLatticePoints() T {
+	return (p.TwiceArea()-p.BoundaryLatticePoints())/2 + 1
+}
+
+// OnBoundary returns true if the point lies on any of the edges of the
+// polygon, including the vertices.
+func (p *Polygon2D[T]) OnBoundary(point Point2D[T]) bool {
+	for i, a := range p.Vertices {
+		edge := NewLineSegment2D(a, p.Vertices[(i+1)%len(p.Vertices)])
+		if edge.Contains(point) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains returns true if the point lies inside the polygon or on its
+// boundary. Use OnBoundary to distinguish between the two cases.
+//
+// This uses the ray casting algorithm where a ray is cast from the point
+// towards +ve X axis and the number of edges it crosses is counted. An edge
+// is considered crossed only if one of its end points is strictly above the
+// ray and the other is not, so the vertices and horizontal edges lying on the
+// ray, which are common in grid polygons, are counted correctly.
+func (p *Polygon2D[T]) Contains(point Point2D[T]) bool {
+	if p.OnBoundary(point) {
+		return true
+	}
+	inside := false
+	for i, a := range p.Vertices {
+		b := p.Vertices[(i+1)%len(p.Vertices)]
+		if (a.Y > point.Y) == (b.Y > point.Y) {
+			continue
+		}
+		// The X coordinate of the intersection is to the right of the point
+		// if (b.X-a.X)*(point.Y-a.Y)/(b.Y-a.Y) > point.X-a.X, which is
+		// compared without the division to avoid rounding errors.
+		lhs := (b.X - a.X) * (point.Y - a.Y)
+		rhs := (point.X - a.X) * (b.Y - a.Y)
+		if (b.Y > a.Y && lhs > rhs) || (b.Y < a.Y && lhs < rhs) {
+			inside = !inside
+		}
+	}
+	return inside
+}
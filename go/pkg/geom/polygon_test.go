@@ -0,0 +1,119 @@
+package geom
+
+import "testing"
+
+func TestPolygon2D(t *testing.T) {
+	testCases := []struct {
+		name      string
+		polygon   *Polygon2D[int]
+		area      float64
+		perimeter float64
+		boundary  int
+		interior  int
+	}{
+		{
+			name:      "rectangle",
+			polygon:   NewPolygon2D[int](Point2D[int]{0, 0}, Point2D[int]{4, 0}, Point2D[int]{4, 3}, Point2D[int]{0, 3}),
+			area:      12,
+			perimeter: 14,
+			boundary:  14,
+			interior:  6,
+		},
+		{
+			name:      "triangle",
+			polygon:   NewPolygon2D[int](Point2D[int]{0, 0}, Point2D[int]{0, 3}, Point2D[int]{4, 0}),
+			area:      6,
+			perimeter: 12,
+			boundary:  8,
+			interior:  3,
+		},
+		{
+			name: "L shape clockwise",
+			polygon: NewPolygon2D[int](
+				Point2D[int]{0, 0}, Point2D[int]{0, 2}, Point2D[int]{1, 2},
+				Point2D[int]{1, 1}, Point2D[int]{2, 1}, Point2D[int]{2, 0},
+			),
+			area:      3,
+			perimeter: 8,
+			boundary:  8,
+			interior:  0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if area := tc.polygon.Area(); area != tc.area {
+				t.Errorf("Area(); expected: %v, actual: %v\n", tc.area, area)
+			}
+			if perimeter := tc.polygon.Perimeter(); perimeter != tc.perimeter {
+				t.Errorf("Perimeter(); expected: %v, actual: %v\n", tc.perimeter, perimeter)
+			}
+			if boundary := tc.polygon.BoundaryLatticePoints(); boundary != tc.boundary {
+				t.Errorf("BoundaryLatticePoints(); expected: %v, actual: %v\n", tc.boundary, boundary)
+			}
+			if interior := tc.polygon.InteriorLatticePoints(); interior != tc.interior {
+				t.Errorf("InteriorLatticePoints(); expected: %v, actual: %v\n", tc.interior, interior)
+			}
+		})
+	}
+}
+
+func TestPolygon2DContains(t *testing.T) {
+	// A "U" shaped polygon with the opening at the top:
+	//
+	//   #####.####
+	//   #...#.#..#
+	//   #...###..#
+	//   #........#
+	//   ##########
+	polygon := NewPolygon2D[int](
+		Point2D[int]{0, 0}, Point2D[int]{4, 0}, Point2D[int]{4, 2}, Point2D[int]{6, 2},
+		Point2D[int]{6, 0}, Point2D[int]{9, 0}, Point2D[int]{9, 4}, Point2D[int]{0, 4},
+	)
+
+	testCases := []struct {
+		point    Point2D[int]
+		contains bool
+		boundary bool
+	}{
+		{point: Point2D[int]{2, 1}, contains: true},
+		{point: Point2D[int]{5, 3}, contains: true},
+		{point: Point2D[int]{5, 1}, contains: false},
+		{point: Point2D[int]{5, 0}, contains: false},
+		{point: Point2D[int]{11, 2}, contains: false},
+		{point: Point2D[int]{-1, 0}, contains: false},
+		{point: Point2D[int]{5, 2}, contains: true, boundary: true},
+		{point: Point2D[int]{9, 0}, contains: true, boundary: true},
+		{point: Point2D[int]{0, 3}, contains: true, boundary: true},
+	}
+
+	for _, tc := range testCases {
+		if contains := polygon.Contains(tc.point); contains != tc.contains {
+			t.Errorf("Contains(%v); expected: %t, actual: %t\n", tc.point, tc.contains, contains)
+		}
+		if boundary := polygon.OnBoundary(tc.point); boundary != tc.boundary {
+			t.Errorf("OnBoundary(%v); expected: %t, actual: %t\n", tc.point, tc.boundary, boundary)
+		}
+	}
+
+	// Every point is either inside, on the boundary or outside, so the count
+	// should agree with Pick's theorem.
+	var inside, boundary int
+	for y := -1; y <= 5; y++ {
+		for x := -1; x <= 10; x++ {
+			p := Point2D[int]{x, y}
+			switch {
+			case polygon.OnBoundary(p):
+				boundary++
+			case polygon.Contains(p):
+				inside++
+			}
+		}
+	}
+	if inside != polygon.InteriorLatticePoints() || boundary != polygon.BoundaryLatticePoints() {
+		t.Errorf(
+			"expected: (%d, %d), actual: (%d, %d)\n",
+			polygon.InteriorLatticePoints(), polygon.BoundaryLatticePoints(), inside, boundary,
+		)
+	}
+}
@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"math"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/matrix"
+)
+
+// Unreachable is the distance between two nodes if there's no path between
+// them, as used by AllPairsShortestPaths.
+const Unreachable = math.MaxInt
+
+// AllPairsShortestPaths computes the length of the shortest path between
+// every pair of nodes in the graph using the Floyd-Warshall algorithm. The
+// element at (i, j) of the returned matrix is the distance from the node at
+// index i to the node at index j as returned by Graph.Index, or Unreachable
+// if there's no path between them. It returns nil if the graph is empty.
+//
+// The complexity is O(n^3) where n is the number of nodes, and the graph
+// should not contain any negative cycles.
+func AllPairsShortestPaths[N comparable](g *Graph[N]) *matrix.Dense[int] {
+	n := g.Len()
+	if n == 0 {
+		return nil
+	}
+	dist := matrix.NewDense[int](n, n, nil)
+	dist.Fill(Unreachable)
+	for i := 0; i < n; i++ {
+		dist.Set(i, i, 0)
+		for _, e := range g.edges[i] {
+			j := g.index[e.To]
+			dist.Set(i, j, min(dist.At(i, j), e.Weight))
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		rowK := dist.RawRowView(k)
+		for i := 0; i < n; i++ {
+			dik := dist.At(i, k)
+			if dik == Unreachable {
+				continue
+			}
+			rowI := dist.RawRowView(i)
+			for j, dkj := range rowK {
+				if dkj != Unreachable && dik+dkj < rowI[j] {
+					rowI[j] = dik + dkj
+				}
+			}
+		}
+	}
+	return dist
+}
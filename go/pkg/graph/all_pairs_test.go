@@ -0,0 +1,39 @@
+package graph
+
+import "testing"
+
+func TestAllPairsShortestPaths(t *testing.T) {
+	g := New[string]()
+	g.AddEdge("a", "b", 4)
+	g.AddEdge("a", "c", 1)
+	g.AddEdge("c", "b", 2)
+	g.AddEdge("b", "d", 5)
+	g.AddEdge("d", "a", -1)
+	g.AddNode("e")
+
+	testCases := []struct {
+		from, to string
+		expected int
+	}{
+		{from: "a", to: "a", expected: 0},
+		{from: "a", to: "b", expected: 3},
+		{from: "a", to: "d", expected: 8},
+		{from: "d", to: "b", expected: 2},
+		{from: "b", to: "c", expected: 5},
+		{from: "a", to: "e", expected: Unreachable},
+		{from: "e", to: "a", expected: Unreachable},
+	}
+
+	dist := AllPairsShortestPaths(g)
+	for _, tc := range testCases {
+		i, _ := g.Index(tc.from)
+		j, _ := g.Index(tc.to)
+		if actual := dist.At(i, j); actual != tc.expected {
+			t.Errorf("distance %s -> %s; expected: %d, actual: %d\n", tc.from, tc.to, tc.expected, actual)
+		}
+	}
+
+	if dist := AllPairsShortestPaths(New[int]()); dist != nil {
+		t.Errorf("empty graph; expected: nil, actual: %v\n", dist)
+	}
+}
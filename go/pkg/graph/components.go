@@ -0,0 +1,118 @@
+package graph
+
+// Components returns the connected components of the undirected graph g.
+// Every component contains the nodes in the order they were added to g and
+// the components are ordered by their first node.
+func Components[N comparable](g *Graph[N]) [][]N {
+	component := make([]int, g.Len())
+	for i := range component {
+		component[i] = -1
+	}
+	count := 0
+	for i := range g.nodes {
+		if component[i] != -1 {
+			continue
+		}
+		component[i] = count
+		stack := []int{i}
+		for len(stack) > 0 {
+			u := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for _, e := range g.edges[u] {
+				if v := g.index[e.To]; component[v] == -1 {
+					component[v] = count
+					stack = append(stack, v)
+				}
+			}
+		}
+		count++
+	}
+
+	components := make([][]N, count)
+	for i, n := range g.nodes {
+		components[component[i]] = append(components[component[i]], n)
+	}
+	return components
+}
+
+// Bridges returns the edges of the undirected graph g whose removal increases
+// the number of connected components. Every bridge is reported once as the
+// pair of nodes in the order of the depth first search. Parallel edges are
+// never bridges.
+func Bridges[N comparable](g *Graph[N]) [][2]N {
+	var bridges [][2]N
+	lowLink(g, func(u, v int, isBridge bool) {
+		if isBridge {
+			bridges = append(bridges, [2]N{g.nodes[u], g.nodes[v]})
+		}
+	}, nil)
+	return bridges
+}
+
+// ArticulationPoints returns the nodes of the undirected graph g whose
+// removal increases the number of connected components, in the order they
+// were added to g.
+func ArticulationPoints[N comparable](g *Graph[N]) []N {
+	isPoint := make([]bool, g.Len())
+	lowLink(g, nil, func(u int) { isPoint[u] = true })
+
+	var points []N
+	for i, n := range g.nodes {
+		if isPoint[i] {
+			points = append(points, n)
+		}
+	}
+	return points
+}
+
+// lowLink performs Tarjan's depth first search over the undirected graph g,
+// calling onTreeEdge for every edge of the search tree along with whether
+// it's a bridge, and onArticulation for every articulation point, possibly
+// multiple times for the same node. Either of the callbacks can be nil.
+func lowLink[N comparable](g *Graph[N], onTreeEdge func(u, v int, isBridge bool), onArticulation func(u int)) {
+	disc := make([]int, g.Len())
+	low := make([]int, g.Len())
+	for i := range disc {
+		disc[i] = -1
+	}
+	timer := 0
+
+	var dfs func(u, parent int)
+	dfs = func(u, parent int) {
+		disc[u], low[u] = timer, timer
+		timer++
+		children := 0
+		skippedParent := false
+		for _, e := range g.edges[u] {
+			v := g.index[e.To]
+			// Only the edge used to reach u is skipped, so a parallel edge
+			// back to the parent is considered as a back edge.
+			if v == parent && !skippedParent {
+				skippedParent = true
+				continue
+			}
+			if disc[v] != -1 {
+				low[u] = min(low[u], disc[v])
+				continue
+			}
+			children++
+			dfs(v, u)
+			low[u] = min(low[u], low[v])
+			if onTreeEdge != nil {
+				onTreeEdge(u, v, low[v] > disc[u])
+			}
+			if onArticulation != nil && parent != -1 && low[v] >= disc[u] {
+				onArticulation(u)
+			}
+		}
+		if onArticulation != nil && parent == -1 && children > 1 {
+			onArticulation(u)
+		}
+	}
+
+	for i := range g.nodes {
+		if disc[i] == -1 {
+			dfs(i, -1)
+		}
+	}
+}
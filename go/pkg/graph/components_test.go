@@ -0,0 +1,51 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+// newBridgeGraph returns the graph:
+//
+//	0 - 1 - 3 - 4     6 = 7
+//	 \ /    |  /
+//	  2     5 -
+//
+// where 6 and 7 are connected by two parallel edges.
+func newBridgeGraph() *Graph[int] {
+	g := New[int]()
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {1, 3}, {3, 4}, {4, 5}, {5, 3}, {6, 7}, {6, 7}} {
+		g.AddUndirectedEdge(e[0], e[1], 1)
+	}
+	g.AddNode(8)
+	return g
+}
+
+func TestComponents(t *testing.T) {
+	expected := [][]int{{0, 1, 2, 3, 4, 5}, {6, 7}, {8}}
+	if actual := Components(newBridgeGraph()); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
+
+func TestBridges(t *testing.T) {
+	expected := [][2]int{{1, 3}}
+	if actual := Bridges(newBridgeGraph()); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
+
+func TestArticulationPoints(t *testing.T) {
+	expected := []int{1, 3}
+	if actual := ArticulationPoints(newBridgeGraph()); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	// The root of the search is an articulation point only if it has more
+	// than one child in the search tree.
+	g := NewUndirected(map[string][]string{"a": {"b"}})
+	g.AddUndirectedEdge("a", "c", 1)
+	if actual := ArticulationPoints(g); !reflect.DeepEqual(actual, []string{"a"}) {
+		t.Errorf("\nexpected: [a]\nactual: %v\n", actual)
+	}
+}
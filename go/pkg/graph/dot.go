@@ -0,0 +1,42 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// dotEscaper escapes a string to be used inside a quoted DOT string, where
+// only the double quote and the backslash need to be escaped.
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// WriteDOT writes the graph g in the Graphviz DOT language to w, which can
+// then be rendered using the dot command, for example, "dot -Tsvg -o
+// graph.svg". Every node is labelled using the labels function, or using its
+// default format if labels is nil, with the double quotes and backslashes
+// escaped. The edges are labelled with their weight unless the weight is 1.
+//
+// The graph is always written as a directed graph, so an undirected edge is
+// written as two edges in the opposite directions.
+func WriteDOT[N comparable](w io.Writer, g *Graph[N], labels func(N) string) error {
+	if labels == nil {
+		labels = func(n N) string { return fmt.Sprint(n) }
+	}
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph {")
+	for i, n := range g.nodes {
+		fmt.Fprintf(bw, "\tn%d [label=\"%s\"];\n", i, dotEscaper.Replace(labels(n)))
+	}
+	for i, edges := range g.edges {
+		for _, e := range edges {
+			fmt.Fprintf(bw, "\tn%d -> n%d", i, g.index[e.To])
+			if e.Weight != 1 {
+				fmt.Fprintf(bw, " [label=\"%d\"]", e.Weight)
+			}
+			fmt.Fprintln(bw, ";")
+		}
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
@@ -0,0 +1,36 @@
+package graph
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+)
+
+func TestWriteDOT(t *testing.T) {
+	g := New[string]()
+	g.AddEdge("AA", "BB", 1)
+	g.AddEdge("BB", "CC", 3)
+	g.AddNode(`"quoted"`)
+	g.AddNode(`back\slash é`)
+
+	var sb strings.Builder
+	if err := WriteDOT(&sb, g, func(n string) string { return "valve " + n }); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := heredoc.Doc(`
+		digraph {
+			n0 [label="valve AA"];
+			n1 [label="valve BB"];
+			n2 [label="valve CC"];
+			n3 [label="valve \"quoted\""];
+			n4 [label="valve back\\slash é"];
+			n0 -> n1;
+			n1 -> n2 [label="3"];
+		}
+	`)
+	if actual := sb.String(); actual != expected {
+		t.Errorf("\nexpected:\n%s\nactual:\n%s\n", expected, actual)
+	}
+}
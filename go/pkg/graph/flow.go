@@ -0,0 +1,165 @@
+package graph
+
+// flowArc is an arc in the residual network used by the max flow algorithm.
+// The reverse arc is at index rev in the adjacency list of the node to.
+type flowArc struct {
+	to, rev, cap int
+}
+
+// flowNetwork is the residual network for a graph where the nodes are
+// identified by their index in the graph.
+type flowNetwork [][]flowArc
+
+// newFlowNetwork creates the residual network for the graph g where the
+// weight of every edge is its capacity.
+func newFlowNetwork[N comparable](g *Graph[N]) flowNetwork {
+	net := make(flowNetwork, g.Len())
+	for i, edges := range g.edges {
+		for _, e := range edges {
+			j := g.index[e.To]
+			net[i] = append(net[i], flowArc{to: j, rev: len(net[j]), cap: e.Weight})
+			net[j] = append(net[j], flowArc{to: i, rev: len(net[i]) - 1, cap: 0})
+		}
+	}
+	return net
+}
+
+// maxFlow pushes the maximum flow from s to t using the Edmonds-Karp
+// algorithm, updating the residual capacities in place, and returns the
+// total flow.
+func (net flowNetwork) maxFlow(s, t int) int {
+	if s == t {
+		return 0
+	}
+	type step struct{ node, arc int }
+	flow := 0
+	for {
+		// Find the shortest augmenting path in the residual network.
+		prev := make([]step, len(net))
+		for i := range prev {
+			prev[i] = step{-1, -1}
+		}
+		prev[s] = step{s, -1}
+		queue := []int{s}
+		for len(queue) > 0 && prev[t].node == -1 {
+			u := queue[0]
+			queue = queue[1:]
+			for i, a := range net[u] {
+				if a.cap > 0 && prev[a.to].node == -1 {
+					prev[a.to] = step{u, i}
+					queue = append(queue, a.to)
+				}
+			}
+		}
+		if prev[t].node == -1 {
+			return flow
+		}
+
+		bottleneck := -1
+		for v := t; v != s; v = prev[v].node {
+			if c := net[prev[v].node][prev[v].arc].cap; bottleneck == -1 || c < bottleneck {
+				bottleneck = c
+			}
+		}
+		for v := t; v != s; v = prev[v].node {
+			a := &net[prev[v].node][prev[v].arc]
+			a.cap -= bottleneck
+			net[v][a.rev].cap += bottleneck
+		}
+		flow += bottleneck
+	}
+}
+
+// reachable returns the nodes which can be reached from s using the arcs
+// with remaining capacity.
+func (net flowNetwork) reachable(s int) []bool {
+	seen := make([]bool, len(net))
+	seen[s] = true
+	stack := []int{s}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, a := range net[u] {
+			if a.cap > 0 && !seen[a.to] {
+				seen[a.to] = true
+				stack = append(stack, a.to)
+			}
+		}
+	}
+	return seen
+}
+
+// MaxFlow returns the maximum flow which can be sent from the source node to
+// the sink node where the weight of every edge is its capacity. For an
+// undirected graph, an edge added using AddUndirectedEdge can carry the flow
+// in either direction. It returns 0 if either of the nodes is not in the
+// graph.
+func MaxFlow[N comparable](g *Graph[N], source, sink N) int {
+	s, ok1 := g.index[source]
+	t, ok2 := g.index[sink]
+	if !ok1 || !ok2 {
+		return 0
+	}
+	return newFlowNetwork(g).maxFlow(s, t)
+}
+
+// MinCut finds the minimum cut separating the source node from the sink
+// node, where the weight of every edge is its capacity. It returns the total
+// weight of the cut, which is the same as the maximum flow, along with the
+// nodes on the source side of the cut and the edges from the source side to
+// the sink side which form the cut.
+//
+// For an undirected graph, every cut edge is reported only once in the
+// direction from the source side to the sink side.
+func MinCut[N comparable](g *Graph[N], source, sink N) (cut int, sourceSide []N, cutEdges [][2]N) {
+	s, ok1 := g.index[source]
+	t, ok2 := g.index[sink]
+	if !ok1 || !ok2 || s == t {
+		return 0, nil, nil
+	}
+	net := newFlowNetwork(g)
+	cut = net.maxFlow(s, t)
+	sourceSide, cutEdges = cutSide(g, net, s)
+	return cut, sourceSide, cutEdges
+}
+
+// GlobalMinCut finds the minimum cut which splits the undirected graph g into
+// two non-empty parts, where the weight of every edge is its capacity. The
+// return values are the same as MinCut where the first node in g is always on
+// the source side. It returns false if the graph has fewer than two nodes.
+//
+// This computes the minimum cut between the first node and every other node,
+// so it's only suitable for graphs where the maximum flow is small.
+func GlobalMinCut[N comparable](g *Graph[N]) (cut int, sourceSide []N, cutEdges [][2]N, ok bool) {
+	if g.Len() < 2 {
+		return 0, nil, nil, false
+	}
+	cut = -1
+	for t := 1; t < g.Len(); t++ {
+		net := newFlowNetwork(g)
+		if flow := net.maxFlow(0, t); cut == -1 || flow < cut {
+			cut = flow
+			sourceSide, cutEdges = cutSide(g, net, 0)
+		}
+	}
+	return cut, sourceSide, cutEdges, true
+}
+
+// cutSide returns the nodes reachable from s in the residual network after
+// the maximum flow has been pushed, along with the edges of g going out of
+// them.
+func cutSide[N comparable](g *Graph[N], net flowNetwork, s int) (sourceSide []N, cutEdges [][2]N) {
+	side := net.reachable(s)
+	for i, n := range g.nodes {
+		if !side[i] {
+			continue
+		}
+		sourceSide = append(sourceSide, n)
+		for _, e := range g.edges[i] {
+			if !side[g.index[e.To]] {
+				cutEdges = append(cutEdges, [2]N{n, e.To})
+			}
+		}
+	}
+	return sourceSide, cutEdges
+}
@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMaxFlow(t *testing.T) {
+	// The classic example from CLRS with the maximum flow of 23.
+	g := New[string]()
+	g.AddEdge("s", "v1", 16)
+	g.AddEdge("s", "v2", 13)
+	g.AddEdge("v2", "v1", 4)
+	g.AddEdge("v1", "v3", 12)
+	g.AddEdge("v3", "v2", 9)
+	g.AddEdge("v2", "v4", 14)
+	g.AddEdge("v4", "v3", 7)
+	g.AddEdge("v3", "t", 20)
+	g.AddEdge("v4", "t", 4)
+
+	if flow := MaxFlow(g, "s", "t"); flow != 23 {
+		t.Errorf("MaxFlow; expected: 23, actual: %d\n", flow)
+	}
+	if flow := MaxFlow(g, "t", "s"); flow != 0 {
+		t.Errorf("MaxFlow reversed; expected: 0, actual: %d\n", flow)
+	}
+
+	cut, side, edges := MinCut(g, "s", "t")
+	total := 0
+	for _, e := range edges {
+		w, _ := g.Weight(e[0], e[1])
+		total += w
+	}
+	if cut != 23 || total != 23 {
+		t.Errorf("MinCut; expected: 23, actual: %d with edges %v\n", cut, edges)
+	}
+	if !slices.Contains(side, "s") || slices.Contains(side, "t") {
+		t.Errorf("MinCut; unexpected source side: %v\n", side)
+	}
+}
+
+func TestGlobalMinCut(t *testing.T) {
+	// Two cliques of four nodes connected by exactly two edges.
+	g := New[int]()
+	for _, clique := range [][]int{{0, 1, 2, 3}, {4, 5, 6, 7}} {
+		for i, a := range clique {
+			for _, b := range clique[i+1:] {
+				g.AddUndirectedEdge(a, b, 1)
+			}
+		}
+	}
+	g.AddUndirectedEdge(1, 5, 1)
+	g.AddUndirectedEdge(3, 6, 1)
+
+	cut, side, edges, ok := GlobalMinCut(g)
+	if !ok || cut != 2 || len(edges) != 2 {
+		t.Fatalf("GlobalMinCut; expected: 2, actual: %d with edges %v\n", cut, edges)
+	}
+	slices.Sort(side)
+	if !slices.Equal(side, []int{0, 1, 2, 3}) {
+		t.Errorf("GlobalMinCut; expected source side: [0 1 2 3], actual: %v\n", side)
+	}
+
+	if _, _, _, ok := GlobalMinCut(New[int]()); ok {
+		t.Error("GlobalMinCut empty graph; expected: false")
+	}
+}
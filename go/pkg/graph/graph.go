@@ -0,0 +1,135 @@
+// Package graph implements a generic directed weighted graph along with the
+// common graph algorithms.
+//
+// The search algorithms accept the neighbors of a node as a function instead
+// of a Graph, so they work for implicit graphs as well, like a grid where the
+// neighbors are computed on the fly. The Neighbors and Edges method values of
+// a Graph can be passed to them for an explicit graph.
+package graph
+
+import "fmt"
+
+// Edge is a directed edge going to the node To with the given Weight. The
+// source node is implied by the context in which the edge is used.
+type Edge[N comparable] struct {
+	To     N
+	Weight int
+}
+
+// Graph is a directed weighted graph using an adjacency list for every node.
+// The nodes are kept in the order in which they were added, so iterating
+// over the graph is deterministic.
+type Graph[N comparable] struct {
+	nodes []N
+	index map[N]int
+	edges [][]Edge[N]
+}
+
+// New returns an initialized empty graph.
+func New[N comparable]() *Graph[N] {
+	return &Graph[N]{index: make(map[N]int)}
+}
+
+// NewUndirected returns a graph containing an undirected edge, with the
+// weight of 1, between every pair of nodes given in the map where the key is
+// connected to every node in the value.
+func NewUndirected[N comparable](adjacent map[N][]N) *Graph[N] {
+	g := New[N]()
+	for from, tos := range adjacent {
+		for _, to := range tos {
+			g.AddUndirectedEdge(from, to, 1)
+		}
+	}
+	return g
+}
+
+// AddNode adds the node n to the graph, if it's not already present, and
+// returns its index. The index is the number of nodes in the graph before
+// the node was added.
+func (g *Graph[N]) AddNode(n N) int {
+	if i, ok := g.index[n]; ok {
+		return i
+	}
+	i := len(g.nodes)
+	g.index[n] = i
+	g.nodes = append(g.nodes, n)
+	g.edges = append(g.edges, nil)
+	return i
+}
+
+// AddEdge adds a directed edge from the node from to the node to with the
+// given weight, adding the nodes if they're not already present. Adding an
+// edge between the same nodes multiple times creates parallel edges.
+func (g *Graph[N]) AddEdge(from, to N, weight int) {
+	i := g.AddNode(from)
+	g.AddNode(to)
+	g.edges[i] = append(g.edges[i], Edge[N]{To: to, Weight: weight})
+}
+
+// AddUndirectedEdge adds an edge in both the directions between the nodes a
+// and b with the given weight.
+func (g *Graph[N]) AddUndirectedEdge(a, b N, weight int) {
+	g.AddEdge(a, b, weight)
+	g.AddEdge(b, a, weight)
+}
+
+// HasNode returns true if the node n is in the graph.
+func (g *Graph[N]) HasNode(n N) bool {
+	_, ok := g.index[n]
+	return ok
+}
+
+// Index returns the index of the node n in the graph as returned by AddNode.
+// It returns false if the node is not in the graph.
+func (g *Graph[N]) Index(n N) (int, bool) {
+	i, ok := g.index[n]
+	return i, ok
+}
+
+// Nodes returns all the nodes in the graph in the order in which they were
+// added. The returned slice should not be modified.
+func (g *Graph[N]) Nodes() []N {
+	return g.nodes
+}
+
+// Len returns the number of nodes in the graph.
+func (g *Graph[N]) Len() int {
+	return len(g.nodes)
+}
+
+// Edges returns the outgoing edges of the node n, nil if it's not in the
+// graph. The returned slice should not be modified.
+func (g *Graph[N]) Edges(n N) []Edge[N] {
+	i, ok := g.index[n]
+	if !ok {
+		return nil
+	}
+	return g.edges[i]
+}
+
+// Neighbors returns the nodes which can be reached from the node n using a
+// single edge.
+func (g *Graph[N]) Neighbors(n N) []N {
+	edges := g.Edges(n)
+	neighbors := make([]N, len(edges))
+	for i, e := range edges {
+		neighbors[i] = e.To
+	}
+	return neighbors
+}
+
+// Weight returns the weight of the edge from the node from to the node to.
+// If there are parallel edges, the one with the lowest weight is considered.
+// It returns false if there's no such edge.
+func (g *Graph[N]) Weight(from, to N) (weight int, ok bool) {
+	for _, e := range g.Edges(from) {
+		if e.To == to && (!ok || e.Weight < weight) {
+			weight, ok = e.Weight, true
+		}
+	}
+	return weight, ok
+}
+
+func (g *Graph[N]) String() string {
+	return fmt.Sprintf("Graph{nodes: %d}", len(g.nodes))
+}
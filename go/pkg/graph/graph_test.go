@@ -0,0 +1,104 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+)
+
+// riskMap is the example from 2021/15 where moving into a cell costs its
+// risk level.
+var riskMap = []string{
+	"1163751742",
+	"1381373672",
+	"2136511328",
+	"3694931569",
+	"7463417111",
+	"1319128137",
+	"1359912421",
+	"3125421639",
+	"1293138521",
+	"2311944581",
+}
+
+func riskEdges(p geom.Point2D[int]) []Edge[geom.Point2D[int]] {
+	bbox := geom.NewBoundingBox2D(0, len(riskMap[0])-1, 0, len(riskMap)-1)
+	var edges []Edge[geom.Point2D[int]]
+	for _, n := range p.NeighborsIn(bbox) {
+		edges = append(edges, Edge[geom.Point2D[int]]{To: n, Weight: int(riskMap[n.Y][n.X] - '0')})
+	}
+	return edges
+}
+
+func TestShortestPath(t *testing.T) {
+	start, end := geom.Point2D[int]{}, geom.Point2D[int]{X: 9, Y: 9}
+	isEnd := func(p geom.Point2D[int]) bool { return p == end }
+
+	dist, path, ok := ShortestPath(start, isEnd, riskEdges)
+	if !ok || dist != 40 {
+		t.Errorf("ShortestPath; expected: 40, actual: %d (%t)\n", dist, ok)
+	}
+	if path[0] != start || path[len(path)-1] != end {
+		t.Errorf("ShortestPath; expected path from %v to %v, actual: %v\n", start, end, path)
+	}
+	total := 0
+	for _, p := range path[1:] {
+		total += int(riskMap[p.Y][p.X] - '0')
+	}
+	if total != dist {
+		t.Errorf("ShortestPath; path weight %d does not match distance %d\n", total, dist)
+	}
+
+	adist, _, _ := AStar(start, isEnd, riskEdges, end.ManhattanDistance)
+	if adist != dist {
+		t.Errorf("AStar; expected: %d, actual: %d\n", dist, adist)
+	}
+	if dists := Distances(start, riskEdges); dists[end] != dist || len(dists) != 100 {
+		t.Errorf("Distances; expected: %d for %d nodes, actual: %d for %d nodes\n", dist, 100, dists[end], len(dists))
+	}
+}
+
+func TestGraphSearch(t *testing.T) {
+	g := NewUndirected(map[string][]string{"start": {"A", "b"}})
+	g.AddUndirectedEdge("A", "c", 1)
+	g.AddUndirectedEdge("A", "end", 1)
+	g.AddUndirectedEdge("b", "d", 1)
+	g.AddUndirectedEdge("b", "end", 1)
+	g.AddNode("unreachable")
+
+	dist, path, ok := BFS("start", func(n string) bool { return n == "end" }, g.Neighbors)
+	if !ok || dist != 2 || len(path) != 3 || path[0] != "start" || path[2] != "end" {
+		t.Errorf("BFS; expected path of length 2, actual: %v (%t)\n", path, ok)
+	}
+	if _, _, ok := BFS("start", func(n string) bool { return n == "unreachable" }, g.Neighbors); ok {
+		t.Error("BFS; expected unreachable node to not be found")
+	}
+	if dists := BFSDistances("start", g.Neighbors); len(dists) != 6 || dists["d"] != 2 {
+		t.Errorf("BFSDistances; unexpected distances: %v\n", dists)
+	}
+
+	var visited []string
+	DFS("A", g.Neighbors, func(n string) bool {
+		visited = append(visited, n)
+		return n != "d"
+	})
+	if expected := []string{"A", "start", "b", "d"}; !reflect.DeepEqual(visited, expected) {
+		t.Errorf("DFS\nexpected: %v\nactual: %v\n", expected, visited)
+	}
+}
+
+func TestGraphWeight(t *testing.T) {
+	g := New[int]()
+	g.AddEdge(1, 2, 5)
+	g.AddEdge(1, 2, 3)
+	if w, ok := g.Weight(1, 2); !ok || w != 3 {
+		t.Errorf("g.Weight(1, 2); expected: 3, actual: %d (%t)\n", w, ok)
+	}
+	if _, ok := g.Weight(2, 1); ok {
+		t.Error("g.Weight(2, 1); expected no edge")
+	}
+	if i, _ := g.Index(2); i != 1 || g.Len() != 2 {
+		t.Errorf("g.Index(2); expected: 1, actual: %d\n", i)
+	}
+}
@@ -0,0 +1,82 @@
+package graph
+
+import "github.com/dhruvmanila/advent-of-code/go/pkg/queue"
+
+// BFS performs a breadth first search from the start node until a node for
+// which the goal function returns true is found. It returns the number of
+// edges between the start and the goal node, along with the path from start
+// to goal, both inclusive. The ok value is false if no goal node is
+// reachable from start.
+func BFS[N comparable](start N, goal func(N) bool, neighbors func(N) []N) (dist int, path []N, ok bool) {
+	prev := make(map[N]N)
+	q := queue.NewUnique(start)
+	for !q.IsEmpty() {
+		n, _ := q.Dequeue()
+		if goal(n) {
+			path = buildPath(prev, start, n)
+			return len(path) - 1, path, true
+		}
+		for _, next := range neighbors(n) {
+			if !q.Seen(next) {
+				prev[next] = n
+				q.Enqueue(next)
+			}
+		}
+	}
+	return 0, nil, false
+}
+
+// BFSDistances performs a breadth first search from the start node visiting
+// every reachable node, and returns the number of edges between the start
+// node and every reachable node, including the start node itself.
+func BFSDistances[N comparable](start N, neighbors func(N) []N) map[N]int {
+	dist := map[N]int{start: 0}
+	q := queue.New(start)
+	for !q.IsEmpty() {
+		n, _ := q.Dequeue()
+		for _, next := range neighbors(n) {
+			if _, seen := dist[next]; !seen {
+				dist[next] = dist[n] + 1
+				q.Enqueue(next)
+			}
+		}
+	}
+	return dist
+}
+
+// DFS performs a depth first search from the start node, calling the visit
+// function for every reachable node in the pre-order, i.e., a node is
+// visited before any of its neighbors. The neighbors are explored in the
+// order they're returned. Every node is visited only once and the search is
+// stopped if the visit function returns false.
+func DFS[N comparable](start N, neighbors func(N) []N, visit func(N) bool) {
+	seen := make(map[N]bool)
+	var dfs func(n N) bool
+	dfs = func(n N) bool {
+		seen[n] = true
+		if !visit(n) {
+			return false
+		}
+		for _, next := range neighbors(n) {
+			if !seen[next] && !dfs(next) {
+				return false
+			}
+		}
+		return true
+	}
+	dfs(start)
+}
+
+// buildPath builds the path from start to end using the map from every node
+// to the node it was reached from.
+func buildPath[N comparable](prev map[N]N, start, end N) []N {
+	path := []N{end}
+	for n := end; n != start; {
+		n = prev[n]
+		path = append(path, n)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
@@ -0,0 +1,77 @@
+package graph
+
+import "github.com/dhruvmanila/advent-of-code/go/pkg/queue"
+
+// ShortestPath finds the shortest path from the start node to a node for
+// which the goal function returns true using Dijkstra's algorithm. It
+// returns the total weight of the path along with the path from start to
+// goal, both inclusive. The ok value is false if no goal node is reachable
+// from start. The edge weights should be non-negative.
+func ShortestPath[N comparable](start N, goal func(N) bool, edges func(N) []Edge[N]) (dist int, path []N, ok bool) {
+	return AStar(start, goal, edges, func(N) int { return 0 })
+}
+
+// AStar finds the shortest path from the start node to a node for which the
+// goal function returns true using the A* search algorithm, guided by the
+// given heuristic function which estimates the remaining distance from a node
+// to the goal. The return values are the same as ShortestPath.
+//
+// The heuristic should be consistent, i.e., it should never overestimate the
+// actual distance and should never decrease by more than the weight of an
+// edge when moving along it, otherwise the path found might not be the
+// shortest. The manhattan distance on a grid is one such heuristic. A
+// heuristic which always returns 0 makes this the same as Dijkstra's
+// algorithm.
+func AStar[N comparable](start N, goal func(N) bool, edges func(N) []Edge[N], heuristic func(N) int) (dist int, path []N, ok bool) {
+	dists := map[N]int{start: 0}
+	prev := make(map[N]N)
+	done := make(map[N]bool)
+
+	pq := queue.NewPriority[N]()
+	pq.Push(start, heuristic(start))
+	for !pq.IsEmpty() {
+		n, _, _ := pq.Pop()
+		if goal(n) {
+			return dists[n], buildPath(prev, start, n), true
+		}
+		done[n] = true
+		for _, e := range edges(n) {
+			if done[e.To] {
+				continue
+			}
+			d := dists[n] + e.Weight
+			if old, seen := dists[e.To]; seen && old <= d {
+				continue
+			}
+			dists[e.To] = d
+			prev[e.To] = n
+			pq.Push(e.To, d+heuristic(e.To))
+		}
+	}
+	return 0, nil, false
+}
+
+// Distances computes the length of the shortest path from the start node to
+// every reachable node using Dijkstra's algorithm, including the start node
+// itself. The edge weights should be non-negative.
+func Distances[N comparable](start N, edges func(N) []Edge[N]) map[N]int {
+	dists := map[N]int{start: 0}
+	done := make(map[N]bool)
+
+	pq := queue.NewPriority[N]()
+	pq.Push(start, 0)
+	for !pq.IsEmpty() {
+		n, d, _ := pq.Pop()
+		done[n] = true
+		for _, e := range edges(n) {
+			if done[e.To] {
+				continue
+			}
+			if old, seen := dists[e.To]; !seen || d+e.Weight < old {
+				dists[e.To] = d + e.Weight
+				pq.Push(e.To, d+e.Weight)
+			}
+		}
+	}
+	return dists
+}
@@ -0,0 +1,174 @@
+// Package grid implements a two dimensional grid indexed by the points in
+// the geom package.
+//
+// A grid is backed by a matrix.Dense where the point {X, Y} corresponds to
+// the element at row Y and column X. This removes the need to juggle between
+// the row-column indices of a matrix and the X-Y coordinates of a point.
+package grid
+
+import (
+	"iter"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/matrix"
+)
+
+// Grid is a two dimensional grid of values with the origin at the top-left
+// corner, X increasing to the right and Y increasing downwards.
+type Grid[T any] struct {
+	m      *matrix.Dense[T]
+	bounds *geom.BoundingBox2D
+}
+
+// New returns a new grid of the given width and height where every value is
+// the zero value of T.
+func New[T any](width, height int) *Grid[T] {
+	return FromMatrix(matrix.NewDense[T](height, width, nil))
+}
+
+// FromMatrix returns a new grid backed by the given matrix. Changes to the
+// grid will be reflected in the matrix and vice versa.
+func FromMatrix[T any](m *matrix.Dense[T]) *Grid[T] {
+	return &Grid[T]{
+		m:      m,
+		bounds: geom.NewBoundingBox2D(0, m.Cols-1, 0, m.Rows-1),
+	}
+}
+
+// Parse returns a new grid from the given lines where every rune of a line is
+// converted to a value using the convert function. It panics if the lines are
+// not of equal length or if there are no lines.
+func Parse[T any](lines []string, convert func(r rune) T) *Grid[T] {
+	runes := matrix.FromRunes(lines)
+	data := make([]T, runes.Rows*runes.Cols)
+	for i, r := range runes.Flatten() {
+		data[i] = convert(r)
+	}
+	return FromMatrix(matrix.NewDense(runes.Rows, runes.Cols, data))
+}
+
+// FromLines returns a new grid from the given lines where every byte of a line
+// is a value in the grid. It panics if the lines are not of equal length or if
+// there are no lines.
+func FromLines(lines []string) *Grid[byte] {
+	return FromMatrix(matrix.FromLines(lines))
+}
+
+// FromDigitLines is similar to FromLines except that every byte of a line is
+// expected to be a decimal digit which is converted to its integer value.
+func FromDigitLines(lines []string) *Grid[int] {
+	return FromMatrix(matrix.FromDigitLines(lines))
+}
+
+// Matrix returns the matrix backing the grid.
+func (g *Grid[T]) Matrix() *matrix.Dense[T] {
+	return g.m
+}
+
+// Width returns the number of columns in the grid.
+func (g *Grid[T]) Width() int {
+	return g.m.Cols
+}
+
+// Height returns the number of rows in the grid.
+func (g *Grid[T]) Height() int {
+	return g.m.Rows
+}
+
+// Bounds returns the bounding box containing all the points in the grid. The
+// returned box should not be modified.
+func (g *Grid[T]) Bounds() *geom.BoundingBox2D {
+	return g.bounds
+}
+
+// InBounds returns true if the point p is inside the grid.
+func (g *Grid[T]) InBounds(p geom.Point2D[int]) bool {
+	return g.bounds.Contains(p.X, p.Y)
+}
+
+// At returns the value at point p. It panics if p is outside the grid.
+func (g *Grid[T]) At(p geom.Point2D[int]) T {
+	return g.m.At(p.Y, p.X)
+}
+
+// AtOk is similar to At except that the boolean is false if p is outside the
+// grid instead of panicking.
+func (g *Grid[T]) AtOk(p geom.Point2D[int]) (T, bool) {
+	return g.m.AtOk(p.Y, p.X)
+}
+
+// Set sets the value at point p to v. It panics if p is outside the grid.
+func (g *Grid[T]) Set(p geom.Point2D[int], v T) {
+	g.m.Set(p.Y, p.X, v)
+}
+
+// Neighbors4 returns the neighboring points of p in the four cardinal
+// directions which are inside the grid.
+func (g *Grid[T]) Neighbors4(p geom.Point2D[int]) []geom.Point2D[int] {
+	return p.NeighborsIn(g.bounds)
+}
+
+// Neighbors8 returns the neighboring points of p in all the eight directions,
+// including the diagonals, which are inside the grid.
+func (g *Grid[T]) Neighbors8(p geom.Point2D[int]) []geom.Point2D[int] {
+	return p.Neighbors8In(g.bounds)
+}
+
+// Find returns the first point, in reading order, whose value satisfies pred.
+// The boolean is false if there is no such point.
+func (g *Grid[T]) Find(pred func(v T) bool) (geom.Point2D[int], bool) {
+	i, j, ok := g.m.Find(pred)
+	return geom.Point2D[int]{X: j, Y: i}, ok
+}
+
+// FindAll returns all the points, in reading order, whose value satisfies
+// pred.
+func (g *Grid[T]) FindAll(pred func(v T) bool) []geom.Point2D[int] {
+	var points []geom.Point2D[int]
+	for p, v := range g.All() {
+		if pred(v) {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+// Count returns the number of points whose value satisfies pred.
+func (g *Grid[T]) Count(pred func(v T) bool) int {
+	return g.m.Count(pred)
+}
+
+// All returns an iterator over all the points and the respective values in
+// the grid in reading order.
+func (g *Grid[T]) All() iter.Seq2[geom.Point2D[int], T] {
+	return func(yield func(geom.Point2D[int], T) bool) {
+		for idx, v := range g.m.All() {
+			if !yield(geom.Point2D[int]{X: idx[1], Y: idx[0]}, v) {
+				return
+			}
+		}
+	}
+}
+
+// Points returns an iterator over all the points in the grid in reading
+// order.
+func (g *Grid[T]) Points() iter.Seq[geom.Point2D[int]] {
+	return g.bounds.Points()
+}
+
+// Copy returns a deep copy of the grid.
+func (g *Grid[T]) Copy() *Grid[T] {
+	return FromMatrix(g.m.Copy())
+}
+
+// Format returns the string representation of the grid where every value is
+// rendered using the given function.
+func (g *Grid[T]) Format(render func(p geom.Point2D[int], v T) string) string {
+	return g.m.FormatFunc(func(i, j int, v T) string {
+		return render(geom.Point2D[int]{X: j, Y: i}, v)
+	})
+}
+
+func (g *Grid[T]) String() string {
+	return g.m.String()
+}
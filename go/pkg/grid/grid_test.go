@@ -0,0 +1,100 @@
+package grid
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+)
+
+func TestGrid(t *testing.T) {
+	g := FromLines([]string{
+		"S.#",
+		"..#",
+		"#.E",
+	})
+
+	if g.Width() != 3 || g.Height() != 3 {
+		t.Errorf("unexpected dimensions: %dx%d\n", g.Width(), g.Height())
+	}
+	end, ok := g.Find(func(v byte) bool { return v == 'E' })
+	if !ok || end != (geom.Point2D[int]{X: 2, Y: 2}) {
+		t.Errorf("g.Find(E); expected: (2, 2), actual: %v (%t)\n", end, ok)
+	}
+	walls := g.FindAll(func(v byte) bool { return v == '#' })
+	expected := []geom.Point2D[int]{{X: 2, Y: 0}, {X: 2, Y: 1}, {X: 0, Y: 2}}
+	if !reflect.DeepEqual(walls, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, walls)
+	}
+	if count := g.Count(func(v byte) bool { return v == '.' }); count != 4 {
+		t.Errorf("g.Count(.); expected: 4, actual: %d\n", count)
+	}
+
+	p := geom.Point2D[int]{X: 1, Y: 0}
+	g.Set(p, 'x')
+	if v := g.At(p); v != 'x' {
+		t.Errorf("g.At(%v); expected: x, actual: %c\n", p, v)
+	}
+	if _, ok := g.AtOk(geom.Point2D[int]{X: 3, Y: 0}); ok || g.InBounds(geom.Point2D[int]{X: -1, Y: 0}) {
+		t.Error("expected the point to be outside the grid")
+	}
+	if s := g.String(); s != "Sx#\n..#\n#.E" {
+		t.Errorf("g.String(); actual: %q\n", s)
+	}
+}
+
+func TestGridNeighbors(t *testing.T) {
+	g := New[int](3, 2)
+
+	testCases := []struct {
+		name     string
+		actual   []geom.Point2D[int]
+		expected []geom.Point2D[int]
+	}{
+		{
+			name:     "4 corner",
+			actual:   g.Neighbors4(geom.Point2D[int]{X: 0, Y: 0}),
+			expected: []geom.Point2D[int]{{X: 1, Y: 0}, {X: 0, Y: 1}},
+		},
+		{
+			name:     "8 edge",
+			actual:   g.Neighbors8(geom.Point2D[int]{X: 1, Y: 1}),
+			expected: []geom.Point2D[int]{{X: 1, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 1}, {X: 0, Y: 1}, {X: 0, Y: 0}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			slices.SortFunc(tc.actual, geom.Point2D[int].Compare)
+			slices.SortFunc(tc.expected, geom.Point2D[int].Compare)
+			if !reflect.DeepEqual(tc.actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, tc.actual)
+			}
+		})
+	}
+}
+
+func TestGridParse(t *testing.T) {
+	g := Parse([]string{"#.", ".#"}, func(r rune) bool { return r == '#' })
+
+	var actual []geom.Point2D[int]
+	for p, v := range g.All() {
+		if v {
+			actual = append(actual, p)
+		}
+	}
+	expected := []geom.Point2D[int]{{X: 0, Y: 0}, {X: 1, Y: 1}}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if n := len(slices.Collect(g.Points())); n != 4 {
+		t.Errorf("g.Points(); expected: 4 points, actual: %d\n", n)
+	}
+
+	c := g.Copy()
+	c.Set(geom.Point2D[int]{X: 1, Y: 0}, true)
+	if g.At(geom.Point2D[int]{X: 1, Y: 0}) {
+		t.Error("g.Copy(); expected copy to be independent")
+	}
+}
@@ -0,0 +1,84 @@
+package grid
+
+import (
+	"slices"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/queue"
+)
+
+// ShortestPath finds the lowest cost path from one point to another moving in
+// the four cardinal directions using Dijkstra's algorithm. It returns the
+// total cost of the path along with the path from start to end, both
+// inclusive. The ok value is false if the end is not reachable.
+//
+// See ShortestPathFunc for the description of cost and passable.
+func (g *Grid[T]) ShortestPath(from, to geom.Point2D[int], cost func(p geom.Point2D[int]) int, passable func(from, to geom.Point2D[int]) bool) (dist int, path []geom.Point2D[int], ok bool) {
+	return g.ShortestPathFunc([]geom.Point2D[int]{from}, func(p geom.Point2D[int]) bool {
+		return p == to
+	}, cost, passable)
+}
+
+// ShortestPathFunc is similar to ShortestPath except that the search starts
+// from all the given sources at once and ends at the first point for which
+// the goal function returns true. The returned path starts at the source
+// closest to the goal.
+//
+// The cost function returns the cost of moving into the point p, which must
+// be a small non-negative integer as the points are kept in a bucket queue.
+// A nil cost function makes every move cost 1. The passable function reports
+// whether a move between the two adjacent points is allowed, where a nil
+// function allows every move inside the grid.
+//
+// Unlike the graph package, the state of the search is kept in slices indexed
+// by the points of the grid, so there are no allocations per point.
+func (g *Grid[T]) ShortestPathFunc(sources []geom.Point2D[int], goal func(p geom.Point2D[int]) bool, cost func(p geom.Point2D[int]) int, passable func(from, to geom.Point2D[int]) bool) (dist int, path []geom.Point2D[int], ok bool) {
+	width := g.Width()
+	index := func(p geom.Point2D[int]) int { return p.Y*width + p.X }
+	point := func(i int) geom.Point2D[int] { return geom.Point2D[int]{X: i % width, Y: i / width} }
+
+	dists := make([]int, width*g.Height())
+	prev := make([]int, len(dists))
+	for i := range dists {
+		dists[i] = -1
+		prev[i] = -1
+	}
+
+	bq := queue.NewBucket[int]()
+	for _, s := range sources {
+		dists[index(s)] = 0
+		bq.Push(index(s), 0)
+	}
+	for !bq.IsEmpty() {
+		i, d, _ := bq.Pop()
+		if d > dists[i] {
+			// Stale entry for a point which was already reached by a
+			// shorter path.
+			continue
+		}
+		p := point(i)
+		if goal(p) {
+			for ; i != -1; i = prev[i] {
+				path = append(path, point(i))
+			}
+			slices.Reverse(path)
+			return d, path, true
+		}
+		for _, direction := range geom.Directions2D {
+			n := p.Add(direction)
+			if !g.InBounds(n) || (passable != nil && !passable(p, n)) {
+				continue
+			}
+			nd := d + 1
+			if cost != nil {
+				nd = d + cost(n)
+			}
+			if j := index(n); dists[j] == -1 || nd < dists[j] {
+				dists[j] = nd
+				prev[j] = i
+				bq.Push(j, nd)
+			}
+		}
+	}
+	return 0, nil, false
+}
@@ -0,0 +1,111 @@
+package grid
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+)
+
+func TestShortestPathCost(t *testing.T) {
+	g := FromDigitLines([]string{
+		"1163751742",
+		"1381373672",
+		"2136511328",
+		"3694931569",
+		"7463417111",
+		"1319128137",
+		"1359912421",
+		"3125421639",
+		"1293138521",
+		"2311944581",
+	})
+	from, to := geom.Point2D[int]{X: 0, Y: 0}, geom.Point2D[int]{X: 9, Y: 9}
+
+	dist, path, ok := g.ShortestPath(from, to, g.At, nil)
+	if !ok || dist != 40 {
+		t.Errorf("expected: 40 (true), actual: %d (%t)\n", dist, ok)
+	}
+	if path[0] != from || path[len(path)-1] != to {
+		t.Errorf("expected path from %v to %v, actual: %v\n", from, to, path)
+	}
+	total := 0
+	for _, p := range path[1:] {
+		total += g.At(p)
+	}
+	if total != dist {
+		t.Errorf("path cost; expected: %d, actual: %d\n", dist, total)
+	}
+}
+
+func TestShortestPathPassable(t *testing.T) {
+	g := FromLines([]string{
+		"S.#.",
+		"#.#.",
+		"...E",
+		"##..",
+	})
+	open := func(_, to geom.Point2D[int]) bool { return g.At(to) != '#' }
+	start := geom.Point2D[int]{X: 0, Y: 0}
+
+	testCases := []struct {
+		name         string
+		to           geom.Point2D[int]
+		expectedDist int
+		expectedPath []geom.Point2D[int]
+		expectedOk   bool
+	}{
+		{
+			name:         "reachable",
+			to:           geom.Point2D[int]{X: 3, Y: 0},
+			expectedDist: 7,
+			expectedPath: []geom.Point2D[int]{
+				{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 1, Y: 2},
+				{X: 2, Y: 2}, {X: 3, Y: 2}, {X: 3, Y: 1}, {X: 3, Y: 0},
+			},
+			expectedOk: true,
+		},
+		{
+			name:         "start",
+			to:           start,
+			expectedDist: 0,
+			expectedPath: []geom.Point2D[int]{start},
+			expectedOk:   true,
+		},
+		{
+			name:       "wall",
+			to:         geom.Point2D[int]{X: 2, Y: 0},
+			expectedOk: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dist, path, ok := g.ShortestPath(start, tc.to, nil, open)
+			if dist != tc.expectedDist || ok != tc.expectedOk {
+				t.Errorf("\nexpected: %d (%t)\nactual: %d (%t)\n", tc.expectedDist, tc.expectedOk, dist, ok)
+			}
+			if !reflect.DeepEqual(path, tc.expectedPath) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expectedPath, path)
+			}
+		})
+	}
+}
+
+func TestShortestPathFunc(t *testing.T) {
+	g := FromLines([]string{
+		"a...",
+		"....",
+		"...a",
+	})
+	sources := g.FindAll(func(v byte) bool { return v == 'a' })
+	end := geom.Point2D[int]{X: 3, Y: 0}
+
+	dist, path, ok := g.ShortestPathFunc(sources, func(p geom.Point2D[int]) bool { return p == end }, nil, nil)
+	if !ok || dist != 2 {
+		t.Errorf("expected: 2 (true), actual: %d (%t)\n", dist, ok)
+	}
+	if path[0] != (geom.Point2D[int]{X: 3, Y: 2}) {
+		t.Errorf("expected path to start at the closest source, actual: %v\n", path)
+	}
+}
@@ -0,0 +1,146 @@
+// Package hashcrack implements a parallel search for the integer suffixes of
+// a salt whose hash satisfies a predicate, like the MD5 hashes starting with
+// a number of zeros.
+//
+// The hashes are computed by a pool of workers in batches while the matches
+// are still delivered in the increasing order of the suffix.
+package hashcrack
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"iter"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// Match is a hash which satisfied the predicate of the search.
+type Match struct {
+	// Index is the integer suffix appended to the salt.
+	Index int
+	// Sum is the hash of the salt followed by the index.
+	Sum []byte
+}
+
+// Hex returns the lowercase hexadecimal encoding of the hash.
+func (m Match) Hex() string {
+	return hex.EncodeToString(m.Sum)
+}
+
+// Searcher contains the configuration of a search. The zero value is a
+// searcher using MD5 with a worker for every CPU.
+type Searcher struct {
+	// Workers is the number of goroutines computing the hashes. The default
+	// is the number of CPUs.
+	Workers int
+	// BatchSize is the number of consecutive indices processed by a worker
+	// at once. The default is 1000.
+	BatchSize int
+	// Stretch is the number of additional times the lowercase hexadecimal
+	// encoding of the hash is hashed again, which is known as key stretching.
+	Stretch int
+	// Hash is the hash function. The default is MD5.
+	Hash func(data []byte) []byte
+}
+
+// Search is a shorthand for the search using the default Searcher.
+func Search(salt string, pred func(sum []byte) bool) iter.Seq[Match] {
+	var s Searcher
+	return s.Search(salt, pred)
+}
+
+// Search returns an iterator over the matches, in the increasing order of the
+// index starting from 0, where the hash of the salt followed by the decimal
+// index satisfies pred. The iterator is infinite, so the caller must stop
+// after finding the matches it needs. The predicate is called concurrently
+// by multiple goroutines, so it must be safe for concurrent use.
+func (s Searcher) Search(salt string, pred func(sum []byte) bool) iter.Seq[Match] {
+	workers := s.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	batchSize := s.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	return func(yield func(Match) bool) {
+		batches := make([][]Match, workers)
+		for base := 0; ; base += workers * batchSize {
+			var wg sync.WaitGroup
+			for w := range batches {
+				wg.Add(1)
+				go func(w int) {
+					defer wg.Done()
+					start := base + w*batchSize
+					batches[w] = s.searchBatch(salt, start, start+batchSize, pred, batches[w][:0])
+				}(w)
+			}
+			wg.Wait()
+
+			for _, batch := range batches {
+				for _, m := range batch {
+					if !yield(m) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// Sum returns the hash of the salt followed by the decimal index, including
+// the key stretching, as computed by the search.
+func (s Searcher) Sum(salt string, index int) []byte {
+	return s.sum(strconv.AppendInt([]byte(salt), int64(index), 10))
+}
+
+// searchBatch appends the matches for the indices in [start, end) to matches
+// and returns the updated slice.
+func (s Searcher) searchBatch(salt string, start, end int, pred func(sum []byte) bool, matches []Match) []Match {
+	data := []byte(salt)
+	for i := start; i < end; i++ {
+		data = strconv.AppendInt(data[:len(salt)], int64(i), 10)
+		if sum := s.sum(data); pred(sum) {
+			matches = append(matches, Match{Index: i, Sum: sum})
+		}
+	}
+	return matches
+}
+
+// sum returns the hash of data with the key stretching.
+func (s Searcher) sum(data []byte) []byte {
+	hash := s.Hash
+	if hash == nil {
+		hash = md5Sum
+	}
+	sum := hash(data)
+	var buf []byte
+	for i := 0; i < s.Stretch; i++ {
+		buf = hex.AppendEncode(buf[:0], sum)
+		sum = hash(buf)
+	}
+	return sum
+}
+
+func md5Sum(data []byte) []byte {
+	sum := md5.Sum(data)
+	return sum[:]
+}
+
+// LeadingZeros returns a predicate which is true for the hashes whose
+// hexadecimal encoding starts with at least n zeros.
+func LeadingZeros(n int) func(sum []byte) bool {
+	return func(sum []byte) bool {
+		if n > 2*len(sum) {
+			return false
+		}
+		for i := 0; i < n/2; i++ {
+			if sum[i] != 0 {
+				return false
+			}
+		}
+		return n%2 == 0 || sum[n/2]&0xF0 == 0
+	}
+}
@@ -0,0 +1,92 @@
+package hashcrack
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLeadingZeros(t *testing.T) {
+	testCases := []struct {
+		name     string
+		n        int
+		sum      []byte
+		expected bool
+	}{
+		{name: "zero", n: 0, sum: []byte{0xff}, expected: true},
+		{name: "odd", n: 3, sum: []byte{0x00, 0x0f, 0xff}, expected: true},
+		{name: "odd mismatch", n: 3, sum: []byte{0x00, 0x10, 0xff}, expected: false},
+		{name: "even", n: 4, sum: []byte{0x00, 0x00, 0xff}, expected: true},
+		{name: "even mismatch", n: 4, sum: []byte{0x00, 0x01, 0xff}, expected: false},
+		{name: "all", n: 4, sum: []byte{0x00, 0x00}, expected: true},
+		{name: "too long", n: 5, sum: []byte{0x00, 0x00}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := LeadingZeros(tc.n)(tc.sum); actual != tc.expected {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSearchOrder(t *testing.T) {
+	s := Searcher{Workers: 4, BatchSize: 3}
+	even := func(sum []byte) bool { return sum[0]%2 == 0 }
+
+	var indices []int
+	for m := range s.Search("abc", even) {
+		if !reflect.DeepEqual(m.Sum, s.Sum("abc", m.Index)) {
+			t.Errorf("index %d: sum does not match the hash\n", m.Index)
+		}
+		indices = append(indices, m.Index)
+		if len(indices) == 30 {
+			break
+		}
+	}
+
+	var expected []int
+	for i := 0; len(expected) < 30; i++ {
+		if even(s.Sum("abc", i)) {
+			expected = append(expected, i)
+		}
+	}
+	if !reflect.DeepEqual(indices, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, indices)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the search for five leading zeros in short mode")
+	}
+	for m := range Search("abc", LeadingZeros(5)) {
+		if m.Index != 3231929 {
+			t.Errorf("\nexpected: %v\nactual: %v\n", 3231929, m.Index)
+		}
+		if expected := "00000155f8105dff7f56ee10fa9b9abd"; m.Hex() != expected {
+			t.Errorf("\nexpected: %v\nactual: %v\n", expected, m.Hex())
+		}
+		break
+	}
+}
+
+func TestSum(t *testing.T) {
+	testCases := []struct {
+		name     string
+		stretch  int
+		expected string
+	}{
+		{name: "plain", stretch: 0, expected: "577571be4de9dcce85a041ba0410f29f"},
+		{name: "stretched", stretch: 2016, expected: "a107ff634856bb300138cac6568c0f24"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := Match{Sum: Searcher{Stretch: tc.stretch}.Sum("abc", 0)}
+			if actual := m.Hex(); actual != tc.expected {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Package heap implements a generic binary heap ordered by a less function.
+//
+// This is similar to the container/heap package except that the five methods
+// of heap.Interface need not be implemented for every element type and there
+// are no type assertions involved.
+package heap
+
+// Heap is a binary heap where the element for which the less function
+// returns true when compared against every other element is at the root.
+// In other words, it is a min-heap as per the less function and a max-heap
+// can be created by reversing the comparison.
+type Heap[T any] struct {
+	data    []T
+	less    func(a, b T) bool
+	onIndex func(e T, i int)
+}
+
+// New returns an initialized empty heap ordered by the given less function.
+func New[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// NewIndexed is similar to New except that onIndex is called with an element
+// and its new index whenever the element is added to the heap or moved within
+// it, and with an index of -1 when it's removed. This allows the caller to
+// keep track of an element's index which is required by Fix and Remove.
+func NewIndexed[T any](less func(a, b T) bool, onIndex func(e T, i int)) *Heap[T] {
+	return &Heap[T]{less: less, onIndex: onIndex}
+}
+
+// NewFromSlice returns a heap ordered by the given less function containing
+// all the elements of the slice. This takes O(n) time and the heap takes
+// ownership of the slice, so it should not be used by the caller afterwards.
+func NewFromSlice[T any](data []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{data: data, less: less}
+	for i := len(data)/2 - 1; i >= 0; i-- {
+		h.down(i, len(data))
+	}
+	return h
+}
+
+// Push adds all the given elements to the heap. The complexity is O(log n)
+// for every element where n is the number of elements in the heap.
+func (h *Heap[T]) Push(es ...T) {
+	for _, e := range es {
+		h.data = append(h.data, e)
+		h.setIndex(e, len(h.data)-1)
+		h.up(len(h.data) - 1)
+	}
+}
+
+// Pop removes and returns the root element of the heap. The complexity is
+// O(log n) where n is the number of elements in the heap.
+//
+// An attempt to pop when the heap is empty will return the zero value for the
+// type of the elements in the heap. Using multiple assignment, one can
+// distinguish a missing entry from a zero value. This is referred to as the
+// "comma ok" idiom.
+func (h *Heap[T]) Pop() (e T, ok bool) {
+	if len(h.data) == 0 {
+		return e, false
+	}
+	return h.Remove(0), true
+}
+
+// Peek returns the root element of the heap without removing it, following
+// the same "comma ok" idiom as Pop.
+func (h *Heap[T]) Peek() (e T, ok bool) {
+	if len(h.data) == 0 {
+		return e, false
+	}
+	return h.data[0], true
+}
+
+// Remove removes and returns the element at index i from the heap. The
+// complexity is O(log n) where n is the number of elements in the heap. It
+// panics if i is out of range.
+func (h *Heap[T]) Remove(i int) T {
+	n := len(h.data) - 1
+	if n != i {
+		h.swap(i, n)
+		if !h.down(i, n) {
+			h.up(i)
+		}
+	}
+	e := h.data[n]
+	var zero T
+	h.data[n] = zero // avoid memory leak
+	h.data = h.data[:n]
+	h.setIndex(e, -1)
+	return e
+}
+
+// Fix re-establishes the heap ordering after the element at index i has
+// changed its value, which is only possible if the elements are pointers or
+// contain pointers. The index of an element is reported by the onIndex
+// function given to NewIndexed. Changing the value of the element at index i
+// followed by a call to Fix is equivalent to, but less expensive than,
+// calling Remove(i) followed by a Push of the new value. The complexity is
+// O(log n) where n is the number of elements in the heap.
+func (h *Heap[T]) Fix(i int) {
+	if !h.down(i, len(h.data)) {
+		h.up(i)
+	}
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.data)
+}
+
+// IsEmpty is used to check whether the heap is empty or not.
+func (h *Heap[T]) IsEmpty() bool {
+	return h.Len() == 0
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.data[i], h.data[j] = h.data[j], h.data[i]
+	h.setIndex(h.data[i], i)
+	h.setIndex(h.data[j], j)
+}
+
+// setIndex reports the index i of the element e to the onIndex function, if
+// any.
+func (h *Heap[T]) setIndex(e T, i int) {
+	if h.onIndex != nil {
+		h.onIndex(e, i)
+	}
+}
+
+// up moves the element at index j towards the root until its parent is not
+// greater than it.
+func (h *Heap[T]) up(j int) {
+	for {
+		i := (j - 1) / 2 // parent
+		if i == j || !h.less(h.data[j], h.data[i]) {
+			break
+		}
+		h.swap(i, j)
+		j = i
+	}
+}
+
+// down moves the element at index i0 towards the leaves until none of its
+// children are less than it, considering only the first n elements. It
+// returns true if the element was moved.
+func (h *Heap[T]) down(i0, n int) bool {
+	i := i0
+	for {
+		j1 := 2*i + 1
+		if j1 >= n || j1 < 0 { // j1 < 0 after int overflow
+			break
+		}
+		j := j1 // left child
+		if j2 := j1 + 1; j2 < n && h.less(h.data[j2], h.data[j1]) {
+			j = j2 // right child
+		}
+		if !h.less(h.data[j], h.data[i]) {
+			break
+		}
+		h.swap(i, j)
+		i = j
+	}
+	return i > i0
+}
@@ -0,0 +1,93 @@
+package heap
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestHeap(t *testing.T) {
+	h := New(func(a, b int) bool { return a < b })
+	if e, ok := h.Pop(); ok {
+		t.Errorf("h.Pop() empty heap; expected: nil, actual: %v\n", e)
+	}
+
+	h.Push(5, 2, 8, 1, 9, 3)
+	if e, _ := h.Peek(); e != 1 {
+		t.Errorf("h.Peek(); expected: 1, actual: %d\n", e)
+	}
+
+	var popped []int
+	for !h.IsEmpty() {
+		e, _ := h.Pop()
+		popped = append(popped, e)
+	}
+	if expected := []int{1, 2, 3, 5, 8, 9}; !reflect.DeepEqual(popped, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, popped)
+	}
+}
+
+func TestHeapFromSlice(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	data := make([]int, 100)
+	for i := range data {
+		data[i] = rng.Intn(50)
+	}
+	expected := slices.Clone(data)
+	slices.Sort(expected)
+	slices.Reverse(expected)
+
+	// Max-heap by reversing the comparison.
+	h := NewFromSlice(data, func(a, b int) bool { return a > b })
+	actual := make([]int, 0, len(expected))
+	for h.Len() > 0 {
+		e, _ := h.Pop()
+		actual = append(actual, e)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
+
+func TestHeapFix(t *testing.T) {
+	type item struct {
+		name     string
+		priority int
+		index    int
+	}
+	less := func(a, b *item) bool { return a.priority < b.priority }
+	onIndex := func(e *item, i int) { e.index = i }
+
+	items := []*item{{name: "a", priority: 4}, {name: "b", priority: 7}, {name: "c", priority: 1}}
+	h := NewIndexed(less, onIndex)
+	h.Push(items...)
+	d := &item{name: "d", priority: 9}
+	e := &item{name: "e", priority: 5}
+	h.Push(d, e)
+	for _, it := range append(slices.Clone(items), d, e) {
+		if h.data[it.index] != it {
+			t.Fatalf("index of %q is %d, but the element at it is %q\n", it.name, it.index, h.data[it.index].name)
+		}
+	}
+
+	// Move d to the front, b behind e and c to the back.
+	d.priority = 0
+	h.Fix(d.index)
+	items[1].priority = 6
+	h.Fix(items[1].index)
+	items[2].priority = 10
+	h.Fix(items[2].index)
+
+	if removed := h.Remove(e.index); removed != e || e.index != -1 {
+		t.Errorf("h.Remove(e.index); expected: e at -1, actual: %q at %d\n", removed.name, e.index)
+	}
+
+	var popped []string
+	for it, ok := h.Pop(); ok; it, ok = h.Pop() {
+		popped = append(popped, it.name)
+	}
+	if expected := []string{"d", "a", "b", "c"}; !reflect.DeepEqual(popped, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, popped)
+	}
+}
@@ -0,0 +1,86 @@
+// Package interval implements the integer ranges and a set of disjoint
+// integer ranges.
+package interval
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Range is an inclusive range of integers from Lo to Hi. A range where Lo is
+// greater than Hi is empty.
+type Range[T constraints.Integer] struct {
+	Lo, Hi T
+}
+
+// New returns a new range from lo to hi, both inclusive.
+func New[T constraints.Integer](lo, hi T) Range[T] {
+	return Range[T]{Lo: lo, Hi: hi}
+}
+
+// IsEmpty returns true if the range does not contain any integer.
+func (r Range[T]) IsEmpty() bool {
+	return r.Lo > r.Hi
+}
+
+// Len returns the number of integers in the range.
+func (r Range[T]) Len() T {
+	if r.IsEmpty() {
+		return 0
+	}
+	return r.Hi - r.Lo + 1
+}
+
+// Contains returns true if x is in the range.
+func (r Range[T]) Contains(x T) bool {
+	return r.Lo <= x && x <= r.Hi
+}
+
+// ContainsRange returns true if every integer in other is also in r. An
+// empty range is contained in every range.
+func (r Range[T]) ContainsRange(other Range[T]) bool {
+	return other.IsEmpty() || (r.Lo <= other.Lo && other.Hi <= r.Hi)
+}
+
+// Overlaps returns true if there's at least one integer in both the ranges.
+func (r Range[T]) Overlaps(other Range[T]) bool {
+	return !r.IsEmpty() && !other.IsEmpty() && r.Lo <= other.Hi && other.Lo <= r.Hi
+}
+
+// Intersect returns the range of integers which are in both r and other. It
+// returns false if the ranges do not overlap.
+func (r Range[T]) Intersect(other Range[T]) (Range[T], bool) {
+	if !r.Overlaps(other) {
+		return Range[T]{}, false
+	}
+	return Range[T]{Lo: max(r.Lo, other.Lo), Hi: min(r.Hi, other.Hi)}, true
+}
+
+// Subtract returns the ranges of integers which are in r but not in other.
+// The result contains at most two ranges, in increasing order.
+func (r Range[T]) Subtract(other Range[T]) []Range[T] {
+	if r.IsEmpty() {
+		return nil
+	}
+	if !r.Overlaps(other) {
+		return []Range[T]{r}
+	}
+	var ranges []Range[T]
+	if r.Lo < other.Lo {
+		ranges = append(ranges, Range[T]{Lo: r.Lo, Hi: other.Lo - 1})
+	}
+	if other.Hi < r.Hi {
+		ranges = append(ranges, Range[T]{Lo: other.Hi + 1, Hi: r.Hi})
+	}
+	return ranges
+}
+
+// Shift returns the range moved by the given offset.
+func (r Range[T]) Shift(offset T) Range[T] {
+	return Range[T]{Lo: r.Lo + offset, Hi: r.Hi + offset}
+}
+
+func (r Range[T]) String() string {
+	return fmt.Sprintf("[%d, %d]", r.Lo, r.Hi)
+}
@@ -0,0 +1,62 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRangeIntersect(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a, b     Range[int]
+		expected Range[int]
+		ok       bool
+	}{
+		{name: "overlap", a: New(1, 5), b: New(3, 8), expected: New(3, 5), ok: true},
+		{name: "contained", a: New(1, 10), b: New(4, 6), expected: New(4, 6), ok: true},
+		{name: "touching", a: New(1, 5), b: New(5, 8), expected: New(5, 5), ok: true},
+		{name: "adjacent", a: New(1, 4), b: New(5, 8)},
+		{name: "empty", a: New(1, 10), b: New(6, 4)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, ok := tc.a.Intersect(tc.b)
+			if ok != tc.ok || actual != tc.expected {
+				t.Errorf("\nexpected: %v (%t)\nactual: %v (%t)\n", tc.expected, tc.ok, actual, ok)
+			}
+		})
+	}
+}
+
+func TestRangeSubtract(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a, b     Range[int]
+		expected []Range[int]
+	}{
+		{name: "middle", a: New(1, 10), b: New(4, 6), expected: []Range[int]{New(1, 3), New(7, 10)}},
+		{name: "left", a: New(1, 10), b: New(-5, 3), expected: []Range[int]{New(4, 10)}},
+		{name: "right", a: New(1, 10), b: New(8, 20), expected: []Range[int]{New(1, 7)}},
+		{name: "disjoint", a: New(1, 10), b: New(11, 20), expected: []Range[int]{New(1, 10)}},
+		{name: "all", a: New(1, 10), b: New(0, 10), expected: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.a.Subtract(tc.b)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRangeLen(t *testing.T) {
+	if l := New(-2, 2).Len(); l != 5 {
+		t.Errorf("New(-2, 2).Len(); expected: 5, actual: %d\n", l)
+	}
+	if l := New(3, 2).Len(); l != 0 {
+		t.Errorf("New(3, 2).Len(); expected: 0, actual: %d\n", l)
+	}
+}
@@ -0,0 +1,154 @@
+package interval
+
+import (
+	"strings"
+
+	"golang.org/x/exp/constraints"
+)
+
+// IntervalSet is a set of integers stored as the sorted list of disjoint
+// ranges. Overlapping or adjacent ranges are merged when inserted.
+//
+// The zero value is an empty set ready to use.
+type IntervalSet[T constraints.Integer] struct {
+	// ranges are sorted by Lo and every range ends at least two integers
+	// before the start of the next one.
+	ranges []Range[T]
+}
+
+// NewSet returns a new set containing the integers in all of the given
+// ranges.
+func NewSet[T constraints.Integer](rs ...Range[T]) *IntervalSet[T] {
+	s := &IntervalSet[T]{}
+	for _, r := range rs {
+		s.Insert(r)
+	}
+	return s
+}
+
+// Insert adds all the integers in the range r to the set.
+func (s *IntervalSet[T]) Insert(r Range[T]) {
+	if r.IsEmpty() {
+		return
+	}
+	ranges := make([]Range[T], 0, len(s.ranges)+1)
+	i := 0
+	for ; i < len(s.ranges) && s.ranges[i].Hi < r.Lo && r.Lo-s.ranges[i].Hi > 1; i++ {
+		ranges = append(ranges, s.ranges[i])
+	}
+	for ; i < len(s.ranges) && (s.ranges[i].Lo <= r.Hi || s.ranges[i].Lo-r.Hi == 1); i++ {
+		r.Lo = min(r.Lo, s.ranges[i].Lo)
+		r.Hi = max(r.Hi, s.ranges[i].Hi)
+	}
+	ranges = append(ranges, r)
+	s.ranges = append(ranges, s.ranges[i:]...)
+}
+
+// Remove removes all the integers in the range r from the set.
+func (s *IntervalSet[T]) Remove(r Range[T]) {
+	if r.IsEmpty() {
+		return
+	}
+	ranges := make([]Range[T], 0, len(s.ranges)+1)
+	for _, sr := range s.ranges {
+		ranges = append(ranges, sr.Subtract(r)...)
+	}
+	s.ranges = ranges
+}
+
+// Contains returns true if x is in the set.
+func (s *IntervalSet[T]) Contains(x T) bool {
+	for _, r := range s.ranges {
+		if r.Lo > x {
+			break
+		}
+		if x <= r.Hi {
+			return true
+		}
+	}
+	return false
+}
+
+// Ranges returns the disjoint ranges in the set in increasing order.
+func (s *IntervalSet[T]) Ranges() []Range[T] {
+	return append([]Range[T](nil), s.ranges...)
+}
+
+// Len returns the number of disjoint ranges in the set.
+func (s *IntervalSet[T]) Len() int {
+	return len(s.ranges)
+}
+
+// IsEmpty is used to check whether the set is empty or not.
+func (s *IntervalSet[T]) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// TotalLength returns the number of integers in the set.
+func (s *IntervalSet[T]) TotalLength() T {
+	var total T
+	for _, r := range s.ranges {
+		total += r.Len()
+	}
+	return total
+}
+
+// Union returns a new set containing the integers which are in either s or
+// other.
+func (s *IntervalSet[T]) Union(other *IntervalSet[T]) *IntervalSet[T] {
+	u := &IntervalSet[T]{ranges: s.Ranges()}
+	for _, r := range other.ranges {
+		u.Insert(r)
+	}
+	return u
+}
+
+// Intersection returns a new set containing the integers which are in both s
+// and other.
+func (s *IntervalSet[T]) Intersection(other *IntervalSet[T]) *IntervalSet[T] {
+	var ranges []Range[T]
+	for i, j := 0, 0; i < len(s.ranges) && j < len(other.ranges); {
+		if r, ok := s.ranges[i].Intersect(other.ranges[j]); ok {
+			ranges = append(ranges, r)
+		}
+		if s.ranges[i].Hi < other.ranges[j].Hi {
+			i++
+		} else {
+			j++
+		}
+	}
+	return &IntervalSet[T]{ranges: ranges}
+}
+
+// Subtract returns a new set containing the integers which are in s but not
+// in other.
+func (s *IntervalSet[T]) Subtract(other *IntervalSet[T]) *IntervalSet[T] {
+	d := &IntervalSet[T]{ranges: s.Ranges()}
+	for _, r := range other.ranges {
+		d.Remove(r)
+	}
+	return d
+}
+
+// Gaps returns the ranges of integers within the given range which are not in
+// the set, in increasing order.
+func (s *IntervalSet[T]) Gaps(within Range[T]) []Range[T] {
+	gaps := NewSet(within)
+	for _, r := range s.ranges {
+		gaps.Remove(r)
+	}
+	return gaps.ranges
+}
+
+func (s *IntervalSet[T]) String() string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, r := range s.ranges {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(r.String())
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
@@ -0,0 +1,101 @@
+package interval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIntervalSetInsert(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ranges   []Range[int]
+		expected []Range[int]
+	}{
+		{
+			name:     "disjoint",
+			ranges:   []Range[int]{New(10, 12), New(1, 3), New(5, 6)},
+			expected: []Range[int]{New(1, 3), New(5, 6), New(10, 12)},
+		},
+		{
+			name:     "overlapping",
+			ranges:   []Range[int]{New(1, 5), New(3, 8), New(10, 12), New(0, 11)},
+			expected: []Range[int]{New(0, 12)},
+		},
+		{
+			name:     "adjacent",
+			ranges:   []Range[int]{New(1, 3), New(7, 9), New(4, 6)},
+			expected: []Range[int]{New(1, 9)},
+		},
+		{
+			name:     "empty",
+			ranges:   []Range[int]{New(5, 1), New(2, 3)},
+			expected: []Range[int]{New(2, 3)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := NewSet(tc.ranges...).Ranges()
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIntervalSetUnsigned(t *testing.T) {
+	s := NewSet(New[uint](0, 2), New[uint](4, 5), New[uint](3, 3))
+	if expected, actual := []Range[uint]{New[uint](0, 5)}, s.Ranges(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
+
+func TestIntervalSetOperations(t *testing.T) {
+	a := NewSet(New(0, 10), New(20, 30))
+	b := NewSet(New(5, 25), New(28, 40))
+
+	testCases := []struct {
+		name     string
+		actual   *IntervalSet[int]
+		expected []Range[int]
+	}{
+		{name: "union", actual: a.Union(b), expected: []Range[int]{New(0, 40)}},
+		{
+			name:     "intersection",
+			actual:   a.Intersection(b),
+			expected: []Range[int]{New(5, 10), New(20, 25), New(28, 30)},
+		},
+		{
+			name:     "subtract",
+			actual:   a.Subtract(b),
+			expected: []Range[int]{New(0, 4), New(26, 27)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.actual.Ranges(); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIntervalSet(t *testing.T) {
+	s := NewSet(New(0, 10), New(20, 30))
+	s.Remove(New(3, 4))
+
+	if total := s.TotalLength(); total != 20 {
+		t.Errorf("s.TotalLength(); expected: 20, actual: %d\n", total)
+	}
+	if !s.Contains(25) || s.Contains(3) || s.Contains(15) {
+		t.Errorf("s.Contains(); unexpected membership for %v\n", s)
+	}
+	expected := []Range[int]{New(-5, -1), New(3, 4), New(11, 19)}
+	if actual := s.Gaps(New(-5, 25)); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if str := s.String(); str != "{[0, 2] [5, 10] [20, 30]}" {
+		t.Errorf("s.String(); actual: %s\n", str)
+	}
+}
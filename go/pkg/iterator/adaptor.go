@@ -0,0 +1,88 @@
+package iterator
+
+import "iter"
+
+// Zip returns an iterator over the pairs of elements yielded by a and b at
+// the same position. It stops as soon as either of them is exhausted.
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		next, stop := iter.Pull(b)
+		defer stop()
+		for va := range a {
+			vb, ok := next()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns an iterator over the elements yielded by seq along with
+// their index, starting from 0.
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Map returns an iterator over the elements yielded by seq transformed using
+// the function f. The function is called lazily as the elements are consumed.
+func Map[T, U any](seq iter.Seq[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns an iterator over the elements yielded by seq for which the
+// predicate function returns true.
+func Filter[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile returns an iterator over the elements yielded by seq until the
+// predicate function returns false for the first time. The element for which
+// the predicate failed is not included.
+func TakeWhile[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !pred(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhile returns an iterator over the elements yielded by seq skipping the
+// elements at the start for which the predicate function returns true. Once
+// the predicate fails, all the remaining elements are yielded including the
+// one for which it failed.
+func DropWhile[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		for v := range seq {
+			if dropping && pred(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package iterator
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	var pairs []string
+	for a, b := range Zip(slices.Values([]string{"a", "b", "c"}), slices.Values([]int{1, 2})) {
+		pairs = append(pairs, a+string(rune('0'+b)))
+	}
+	if expected := []string{"a1", "b2"}; !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, pairs)
+	}
+
+	// Stopping early should not consume the rest of the sequences.
+	for range Zip(slices.Values([]int{1, 2}), slices.Values([]int{3, 4})) {
+		break
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	var indices []int
+	var values []string
+	for i, v := range Enumerate(slices.Values([]string{"x", "y", "z"})) {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	if expected := []int{0, 1, 2}; !reflect.DeepEqual(indices, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, indices)
+	}
+	if expected := []string{"x", "y", "z"}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, values)
+	}
+}
+
+func TestCombinators(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5, 2, 1}
+	isSmall := func(n int) bool { return n < 3 }
+
+	testCases := []struct {
+		name     string
+		actual   []int
+		expected []int
+	}{
+		{name: "map", actual: slices.Collect(Map(slices.Values(data), func(n int) int { return n * n })), expected: []int{1, 4, 9, 16, 25, 4, 1}},
+		{name: "filter", actual: slices.Collect(Filter(slices.Values(data), isSmall)), expected: []int{1, 2, 2, 1}},
+		{name: "take while", actual: slices.Collect(TakeWhile(slices.Values(data), isSmall)), expected: []int{1, 2}},
+		{name: "drop while", actual: slices.Collect(DropWhile(slices.Values(data), isSmall)), expected: []int{3, 4, 5, 2, 1}},
+		{
+			name:     "composed",
+			actual:   slices.Collect(Map(Filter(slices.Values(data), isSmall), func(n int) int { return -n })),
+			expected: []int{-1, -2, -2, -1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, tc.actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package iterator
+
+import "iter"
+
+// Chain creates a new iterator over the remaining elements of all the given
+// iterators, one after the other in the same order as provided. The given
+// iterators are not advanced.
+func Chain[T any](its ...*Iterator[T]) *Iterator[T] {
+	var data []T
+	for _, it := range its {
+		if start := it.idx + 1; start < len(it.data) {
+			data = append(data, it.data[start:]...)
+		}
+	}
+	return New(data)
+}
+
+// Concat returns an iterator over the elements yielded by all the given
+// sequences, one after the other in the same order as provided.
+func Concat[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, seq := range seqs {
+			for v := range seq {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package iterator
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	a, b := New([]string{"a", "b", "c"}), New([]string{"[[2]]", "[[6]]"})
+	a.Next()
+
+	it := Chain(a, New[string](nil), b)
+	if length := it.Len(); length != 4 {
+		t.Errorf("it.Len(); expected: 4, actual: %d\n", length)
+	}
+	if actual, expected := slices.Collect(it.Seq()), []string{"b", "c", "[[2]]", "[[6]]"}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if v := a.Value(); v != "a" {
+		t.Errorf("a.Value(); expected source iterator to not advance, actual: %s\n", v)
+	}
+}
+
+func TestConcat(t *testing.T) {
+	seq := Concat(slices.Values([]int{1, 2}), slices.Values([]int{}), slices.Values([]int{3}))
+	if actual, expected := slices.Collect(seq), []int{1, 2, 3}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
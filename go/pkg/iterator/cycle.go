@@ -1,5 +1,12 @@
 package iterator
 
+import (
+	"iter"
+	"slices"
+)
+
+// Cycle is an iterator which repeats the elements indefinitely, starting over
+// from the first element once the last one is reached.
 type Cycle[T any] struct {
 	Iterator[T]
 }
@@ -11,6 +18,12 @@ func NewCycle[T any](data []T) *Cycle[T] {
 	}
 }
 
+// NewCycleFromSeq creates a new cycle iterator for the elements yielded by
+// the given sequence. The sequence is consumed immediately.
+func NewCycleFromSeq[T any](seq iter.Seq[T]) *Cycle[T] {
+	return NewCycle(slices.Collect(seq))
+}
+
 // Next increments the iterator index. It always returns true as it resets
 // the index to cycle around.
 func (it *Cycle[T]) Next() bool {
@@ -27,3 +40,72 @@ func (it *Cycle[T]) Value() T {
 	// the iterator is exhausted.
 	return it.data[it.idx]
 }
+
+// Advance moves the iterator n elements forward as if Next was called n
+// times. This takes constant time irrespective of n as the index is computed
+// using modular arithmetic. A negative n is a no-op.
+func (it *Cycle[T]) Advance(n int) {
+	if n <= 0 || len(it.data) == 0 {
+		return
+	}
+	it.idx = (it.idx + n) % len(it.data)
+}
+
+// Skip discards the next n elements, returning the iterator itself so that
+// the calls can be chained like it.Skip(3).Take(2).
+func (it *Cycle[T]) Skip(n int) *Cycle[T] {
+	it.Advance(n)
+	return it
+}
+
+// Take returns the next n elements, advancing the iterator past them. The
+// same element can occur multiple times if n is greater than the number of
+// elements. It returns nil if there are no elements.
+func (it *Cycle[T]) Take(n int) []T {
+	if len(it.data) == 0 {
+		return nil
+	}
+	elements := make([]T, 0, n)
+	for ; n > 0 && it.Next(); n-- {
+		elements = append(elements, it.Value())
+	}
+	return elements
+}
+
+// Nth advances the iterator n+1 elements and returns the element at that
+// position, so Nth(0) is the same as calling Next followed by Value. If
+// there are no elements, it returns the zero value for the type T.
+func (it *Cycle[T]) Nth(n int) T {
+	if len(it.data) == 0 || n < 0 {
+		var v T
+		return v
+	}
+	it.Advance(n + 1)
+	return it.Value()
+}
+
+// Seq returns an infinite iterator over the elements, advancing the cycle
+// iterator as the elements are consumed. It yields nothing if there are no
+// elements. The loop should be stopped explicitly, after which Next resumes
+// from the element after the last yielded one.
+func (it *Cycle[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.Next() {
+			if !yield(it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 is similar to Seq, except the index of every element in the original
+// slice is yielded as well.
+func (it *Cycle[T]) Seq2() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for it.Next() {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package iterator
+
+import "iter"
+
+// GroupBy returns an iterator over the groups of consecutive elements yielded
+// by seq which have the same key, as computed by the key function. Each group
+// is yielded along with its key. Similar to Python's itertools.groupby, the
+// elements with the same key which are not consecutive are yielded in
+// separate groups.
+func GroupBy[T any, K comparable](seq iter.Seq[T], key func(T) K) iter.Seq2[K, []T] {
+	return func(yield func(K, []T) bool) {
+		var group []T
+		var current K
+		for v := range seq {
+			k := key(v)
+			if len(group) > 0 && k != current {
+				if !yield(current, group) {
+					return
+				}
+				group = nil
+			}
+			current = k
+			group = append(group, v)
+		}
+		if len(group) > 0 {
+			yield(current, group)
+		}
+	}
+}
+
+// RunLengths returns an iterator over the run-length encoding of the elements
+// yielded by seq, i.e., every run of consecutive equal elements is yielded as
+// the element along with the length of the run.
+func RunLengths[T comparable](seq iter.Seq[T]) iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		var current T
+		count := 0
+		for v := range seq {
+			if count > 0 && v != current {
+				if !yield(current, count) {
+					return
+				}
+				count = 0
+			}
+			current = v
+			count++
+		}
+		if count > 0 {
+			yield(current, count)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package iterator
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGroupBy(t *testing.T) {
+	words := []string{"apple", "avocado", "banana", "blueberry", "cherry", "apricot"}
+
+	var keys []byte
+	var groups [][]string
+	for k, group := range GroupBy(slices.Values(words), func(s string) byte { return s[0] }) {
+		keys = append(keys, k)
+		groups = append(groups, group)
+	}
+
+	if expected := []byte("abca"); !reflect.DeepEqual(keys, expected) {
+		t.Errorf("\nexpected: %q\nactual: %q\n", expected, keys)
+	}
+	expected := [][]string{{"apple", "avocado"}, {"banana", "blueberry"}, {"cherry"}, {"apricot"}}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, groups)
+	}
+}
+
+func TestRunLengths(t *testing.T) {
+	// One step of the look-and-say sequence.
+	var sb strings.Builder
+	for r, n := range RunLengths(slices.Values([]rune("111221"))) {
+		sb.WriteRune(rune('0' + n))
+		sb.WriteRune(r)
+	}
+	if actual := sb.String(); actual != "312211" {
+		t.Errorf("\nexpected: 312211\nactual: %s\n", actual)
+	}
+
+	for range RunLengths(slices.Values([]int(nil))) {
+		t.Error("RunLengths(empty); expected no runs")
+	}
+}
@@ -1,6 +1,11 @@
 // Package iterator implements a generic iterator protocol.
 package iterator
 
+import (
+	"iter"
+	"slices"
+)
+
 type Iterator[T any] struct {
 	idx  int
 	data []T
@@ -11,6 +16,12 @@ func New[T any](data []T) *Iterator[T] {
 	return &Iterator[T]{data: data, idx: -1}
 }
 
+// NewFromSeq creates a new iterator for the elements yielded by the given
+// sequence. The sequence is consumed immediately.
+func NewFromSeq[T any](seq iter.Seq[T]) *Iterator[T] {
+	return New(slices.Collect(seq))
+}
+
 // Len returns the remaining number of items to be iterated over.
 func (it *Iterator[T]) Len() int {
 	if it.idx >= len(it.data) {
@@ -52,3 +63,29 @@ func (it *Iterator[T]) Move(i int) {
 func (it *Iterator[T]) Reset() {
 	it.idx = -1
 }
+
+// Seq returns an iterator over the remaining elements, advancing the
+// iterator as the elements are consumed. If the loop is stopped early, the
+// iterator is left at the last yielded element, so Value returns it and Next
+// resumes from the element after it.
+func (it *Iterator[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.Next() {
+			if !yield(it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Seq2 is similar to Seq, except the index of every element is yielded as
+// well.
+func (it *Iterator[T]) Seq2() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for it.Next() {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package iterator
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestIteratorSeq(t *testing.T) {
+	it := NewFromSeq(slices.Values([]int{1, 2, 3, 4}))
+	it.Next()
+
+	var values []int
+	for v := range it.Seq() {
+		values = append(values, v)
+		if v == 3 {
+			break
+		}
+	}
+	if expected := []int{2, 3}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, values)
+	}
+	if v := it.Value(); v != 3 {
+		t.Errorf("it.Value() after break; expected: 3, actual: %d\n", v)
+	}
+
+	var indices []int
+	for i := range it.Seq2() {
+		indices = append(indices, i)
+	}
+	if expected := []int{3}; !reflect.DeepEqual(indices, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, indices)
+	}
+}
+
+func TestCycleSeq(t *testing.T) {
+	it := NewCycleFromSeq(slices.Values([]string{"a", "b", "c"}))
+
+	var values []string
+	var indices []int
+	for i, v := range it.Seq2() {
+		if len(values) == 7 {
+			break
+		}
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	if expected := []string{"a", "b", "c", "a", "b", "c", "a"}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, values)
+	}
+	if expected := []int{0, 1, 2, 0, 1, 2, 0}; !reflect.DeepEqual(indices, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, indices)
+	}
+
+	for range NewCycle([]int{}).Seq() {
+		t.Fatal("empty cycle; expected no elements")
+	}
+}
+
+func TestCycleUtilities(t *testing.T) {
+	it := NewCycle([]int{0, 1, 2, 3, 4})
+
+	if actual := it.Take(3); !reflect.DeepEqual(actual, []int{0, 1, 2}) {
+		t.Errorf("it.Take(3); expected: [0 1 2], actual: %v\n", actual)
+	}
+	if actual := it.Skip(4).Take(4); !reflect.DeepEqual(actual, []int{2, 3, 4, 0}) {
+		t.Errorf("it.Skip(4).Take(4); expected: [2 3 4 0], actual: %v\n", actual)
+	}
+	if actual := it.Nth(0); actual != 1 {
+		t.Errorf("it.Nth(0); expected: 1, actual: %d\n", actual)
+	}
+	if actual := it.Nth(1_000_003); actual != 0 {
+		t.Errorf("it.Nth(1_000_003); expected: 0, actual: %d\n", actual)
+	}
+
+	// Advance should behave exactly like calling Next n times.
+	for n := 0; n < 12; n++ {
+		a, b := NewCycle([]int{0, 1, 2}), NewCycle([]int{0, 1, 2})
+		a.Advance(n)
+		for i := 0; i < n; i++ {
+			b.Next()
+		}
+		if a.Index() != b.Index() {
+			t.Errorf("Advance(%d); expected index: %d, actual: %d\n", n, b.Index(), a.Index())
+		}
+	}
+}
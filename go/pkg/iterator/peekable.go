@@ -0,0 +1,77 @@
+package iterator
+
+import "iter"
+
+// Peekable is an iterator over the elements yielded by a sequence which can
+// look at the next element without consuming it. It follows the same
+// protocol as Iterator where Next advances the iterator and Value returns
+// the current element.
+//
+// The underlying sequence is pulled one element at a time, so Stop must be
+// called if the iterator is not exhausted.
+type Peekable[T any] struct {
+	next func() (T, bool)
+	stop func()
+
+	value T
+
+	// peeked is true if the next element has already been pulled from the
+	// sequence in which case it's stored in peekedValue along with peekedOk.
+	peeked      bool
+	peekedValue T
+	peekedOk    bool
+}
+
+// NewPeekable creates a new peekable iterator for the given sequence.
+func NewPeekable[T any](seq iter.Seq[T]) *Peekable[T] {
+	next, stop := iter.Pull(seq)
+	return &Peekable[T]{next: next, stop: stop}
+}
+
+// Next returns true if there are any elements remaining to iterate, false
+// otherwise.
+func (p *Peekable[T]) Next() bool {
+	v, ok := p.pull()
+	p.value = v
+	return ok
+}
+
+// Value returns the current element. Next must have been called prior to a
+// call to Value. If the iterator is exhausted, it will return the zero value
+// for the type T.
+func (p *Peekable[T]) Value() T {
+	return p.value
+}
+
+// Peek returns the next element without advancing the iterator, which means
+// the following call to Next will make it the current element.
+//
+// An attempt to peek when the iterator is exhausted will return the zero
+// value for the type T. Using multiple assignment, one can distinguish a
+// missing entry from a zero value. This is referred to as the "comma ok"
+// idiom.
+func (p *Peekable[T]) Peek() (v T, ok bool) {
+	if !p.peeked {
+		p.peekedValue, p.peekedOk = p.next()
+		p.peeked = true
+	}
+	return p.peekedValue, p.peekedOk
+}
+
+// Stop stops the iteration, releasing the resources held by the underlying
+// sequence. It's safe to call Stop multiple times, after which Next returns
+// false.
+func (p *Peekable[T]) Stop() {
+	p.stop()
+	p.peeked = false
+}
+
+// pull returns the next element, either the one which was peeked or by
+// pulling it from the sequence.
+func (p *Peekable[T]) pull() (T, bool) {
+	if p.peeked {
+		p.peeked = false
+		return p.peekedValue, p.peekedOk
+	}
+	return p.next()
+}
@@ -0,0 +1,50 @@
+package iterator
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPeekable(t *testing.T) {
+	p := NewPeekable(slices.Values([]int{1, 2, 3}))
+	defer p.Stop()
+
+	if v, ok := p.Peek(); !ok || v != 1 {
+		t.Errorf("p.Peek(); expected: 1, actual: %d\n", v)
+	}
+	// Peeking multiple times should not consume the element.
+	if v, _ := p.Peek(); v != 1 {
+		t.Errorf("p.Peek() again; expected: 1, actual: %d\n", v)
+	}
+	if !p.Next() || p.Value() != 1 {
+		t.Errorf("p.Next(); expected: 1, actual: %d\n", p.Value())
+	}
+	if !p.Next() || p.Value() != 2 {
+		t.Errorf("p.Next(); expected: 2, actual: %d\n", p.Value())
+	}
+	if v, _ := p.Peek(); v != 3 {
+		t.Errorf("p.Peek(); expected: 3, actual: %d\n", v)
+	}
+	if p.Value() != 2 {
+		t.Errorf("p.Value() after peek; expected: 2, actual: %d\n", p.Value())
+	}
+	if !p.Next() || p.Value() != 3 {
+		t.Errorf("p.Next(); expected: 3, actual: %d\n", p.Value())
+	}
+	if v, ok := p.Peek(); ok {
+		t.Errorf("p.Peek() exhausted; expected: nil, actual: %d\n", v)
+	}
+	if p.Next() {
+		t.Errorf("p.Next() exhausted; expected: false, actual: true\n")
+	}
+}
+
+func TestPeekableStop(t *testing.T) {
+	p := NewPeekable(slices.Values([]string{"a", "b"}))
+	p.Next()
+	p.Stop()
+	p.Stop()
+	if p.Next() {
+		t.Errorf("p.Next() after stop; expected: false, actual: true\n")
+	}
+}
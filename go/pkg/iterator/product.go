@@ -0,0 +1,43 @@
+package iterator
+
+import "iter"
+
+// Product returns an iterator over the cartesian product of the given slices,
+// i.e., every combination formed by picking one element from each slice in
+// order. The combinations are generated lazily in lexicographic order of the
+// indices where the last slice advances the fastest, like an odometer.
+//
+// Every combination is a newly allocated slice, so it's safe to retain it. If
+// any of the slices is empty, nothing is yielded.
+func Product[T any](seqs ...[]T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for _, s := range seqs {
+			if len(s) == 0 {
+				return
+			}
+		}
+		indices := make([]int, len(seqs))
+		for {
+			combination := make([]T, len(seqs))
+			for i, idx := range indices {
+				combination[i] = seqs[i][idx]
+			}
+			if !yield(combination) {
+				return
+			}
+			// Advance the indices from the last slice, carrying over to the
+			// previous one on wrap around.
+			i := len(indices) - 1
+			for ; i >= 0; i-- {
+				indices[i]++
+				if indices[i] < len(seqs[i]) {
+					break
+				}
+				indices[i] = 0
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
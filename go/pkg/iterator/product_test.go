@@ -0,0 +1,45 @@
+package iterator
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestProduct(t *testing.T) {
+	testCases := []struct {
+		name     string
+		seqs     [][]int
+		expected [][]int
+	}{
+		{
+			name:     "two slices",
+			seqs:     [][]int{{1, 2}, {3, 4, 5}},
+			expected: [][]int{{1, 3}, {1, 4}, {1, 5}, {2, 3}, {2, 4}, {2, 5}},
+		},
+		{
+			name:     "single slice",
+			seqs:     [][]int{{7, 8}},
+			expected: [][]int{{7}, {8}},
+		},
+		{
+			name:     "no slices",
+			seqs:     nil,
+			expected: [][]int{{}},
+		},
+		{
+			name:     "empty slice",
+			seqs:     [][]int{{1, 2}, {}},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := slices.Collect(Product(tc.seqs...))
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
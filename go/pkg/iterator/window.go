@@ -0,0 +1,81 @@
+package iterator
+
+import "iter"
+
+// Windows returns an iterator over all the contiguous windows of length n of
+// the elements yielded by seq. The windows overlap and if seq yields fewer
+// than n elements, no window is yielded. Every window is a newly allocated
+// slice, so it's safe to retain it. It panics if n is less than 1.
+func Windows[T any](seq iter.Seq[T], n int) iter.Seq[[]T] {
+	if n < 1 {
+		panic("iterator: window size should be at least 1")
+	}
+	return func(yield func([]T) bool) {
+		window := make([]T, 0, n)
+		for v := range seq {
+			if len(window) == n {
+				window = append(window[:0:0], window[1:]...)
+			}
+			window = append(window, v)
+			if len(window) == n && !yield(window) {
+				return
+			}
+		}
+	}
+}
+
+// Chunks returns an iterator over consecutive non-overlapping chunks of length
+// n of the elements yielded by seq. The last chunk will be shorter if the
+// number of elements is not a multiple of n. Every chunk is a newly allocated
+// slice, so it's safe to retain it. It panics if n is less than 1.
+func Chunks[T any](seq iter.Seq[T], n int) iter.Seq[[]T] {
+	if n < 1 {
+		panic("iterator: chunk size should be at least 1")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, n)
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) == n {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, n)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// WindowsSlice is similar to Windows but for a slice. The windows are
+// subslices of s, sharing the underlying array, to avoid the allocations.
+func WindowsSlice[T any](s []T, n int) iter.Seq[[]T] {
+	if n < 1 {
+		panic("iterator: window size should be at least 1")
+	}
+	return func(yield func([]T) bool) {
+		for i := 0; i+n <= len(s); i++ {
+			if !yield(s[i : i+n : i+n]) {
+				return
+			}
+		}
+	}
+}
+
+// ChunksSlice is similar to Chunks but for a slice. The chunks are subslices
+// of s, sharing the underlying array, to avoid the allocations.
+func ChunksSlice[T any](s []T, n int) iter.Seq[[]T] {
+	if n < 1 {
+		panic("iterator: chunk size should be at least 1")
+	}
+	return func(yield func([]T) bool) {
+		for i := 0; i < len(s); i += n {
+			end := min(i+n, len(s))
+			if !yield(s[i:end:end]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package iterator
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestWindows(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+
+	testCases := []struct {
+		name     string
+		actual   [][]int
+		expected [][]int
+	}{
+		{
+			name:     "windows",
+			actual:   slices.Collect(Windows(slices.Values(data), 3)),
+			expected: [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}},
+		},
+		{
+			name:     "windows larger than data",
+			actual:   slices.Collect(Windows(slices.Values(data), 6)),
+			expected: nil,
+		},
+		{
+			name:     "windows slice",
+			actual:   slices.Collect(WindowsSlice(data, 4)),
+			expected: [][]int{{1, 2, 3, 4}, {2, 3, 4, 5}},
+		},
+		{
+			name:     "chunks",
+			actual:   slices.Collect(Chunks(slices.Values(data), 2)),
+			expected: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name:     "chunks slice",
+			actual:   slices.Collect(ChunksSlice(data, 5)),
+			expected: [][]int{{1, 2, 3, 4, 5}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tc.actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, tc.actual)
+			}
+		})
+	}
+}
+
+func TestWindowsRetained(t *testing.T) {
+	var sums []int
+	for w := range Windows(slices.Values([]int{199, 200, 208, 210, 200}), 3) {
+		sums = append(sums, w[0]+w[1]+w[2])
+	}
+	if expected := []int{607, 618, 618}; !reflect.DeepEqual(sums, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, sums)
+	}
+}
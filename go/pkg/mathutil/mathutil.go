@@ -0,0 +1,127 @@
+// Package mathutil implements the number theory functions like the greatest
+// common divisor, modular arithmetic and the Chinese Remainder Theorem, along
+// with the polynomial extrapolation of integer sequences and the modular
+// matrix power for solving linear recurrences.
+package mathutil
+
+import (
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+
+	"github.com/dhruvmanila/advent-of-code/go/util"
+)
+
+// GCD returns the non-negative greatest common divisor of all the given
+// integers.
+func GCD[T constraints.Integer](a, b T, rest ...T) T {
+	g := gcd(util.Abs(a), util.Abs(b))
+	for _, n := range rest {
+		g = gcd(g, util.Abs(n))
+	}
+	return g
+}
+
+// LCM returns the non-negative least common multiple of all the given
+// integers. It returns 0 if any of the integers is zero.
+func LCM[T constraints.Integer](a, b T, rest ...T) T {
+	l := lcm(util.Abs(a), util.Abs(b))
+	for _, n := range rest {
+		l = lcm(l, util.Abs(n))
+	}
+	return l
+}
+
+// ExtendedGCD returns the greatest common divisor g of a and b along with the
+// Bézout coefficients x and y such that a*x + b*y = g.
+func ExtendedGCD[T constraints.Signed](a, b T) (g, x, y T) {
+	oldR, r := a, b
+	oldX, x := T(1), T(0)
+	oldY, y := T(0), T(1)
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// ModInverse returns the modular multiplicative inverse of a modulo m, that
+// is the integer x in [0, m) such that a*x ≡ 1 (mod m). It returns false if
+// the inverse does not exist, which is when a and m are not coprime.
+func ModInverse(a, m int) (int, bool) {
+	g, x, _ := ExtendedGCD(util.Mod(a, m), m)
+	if g != 1 {
+		return 0, false
+	}
+	return util.Mod(x, m), true
+}
+
+// ModPow returns base**exp modulo m in [0, m) using binary exponentiation.
+// The exponent must be non-negative and the modulus positive.
+func ModPow(base, exp, m int) int {
+	result := 1 % m
+	base = util.Mod(base, m)
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = MulMod(result, base, m)
+		}
+		base = MulMod(base, base, m)
+	}
+	return result
+}
+
+// MulMod returns a*b modulo m in [0, m) without overflowing the intermediate
+// product. The modulus must be positive.
+func MulMod(a, b, m int) int {
+	hi, lo := bits.Mul64(uint64(util.Mod(a, m)), uint64(util.Mod(b, m)))
+	return int(bits.Rem64(hi, lo, uint64(m)))
+}
+
+// CRT solves the system of congruences x ≡ remainders[i] (mod moduli[i])
+// using the Chinese Remainder Theorem. It returns the smallest non-negative
+// solution x along with the modulus of the solution, which is the least
+// common multiple of all the moduli, such that every solution is congruent
+// to x modulo it.
+//
+// The moduli need not be pairwise coprime. It returns false if there's no
+// solution to the system. It panics if the slices are of different lengths.
+func CRT(remainders, moduli []int) (x, m int, ok bool) {
+	if len(remainders) != len(moduli) {
+		panic("mathutil: remainders and moduli are of different lengths")
+	}
+	x, m = 0, 1
+	for i, r := range remainders {
+		// Solve x + m*t ≡ r (mod n) for t.
+		n := moduli[i]
+		g, p, _ := ExtendedGCD(m, n)
+		diff := r - x
+		if diff%g != 0 {
+			return 0, 0, false
+		}
+		step := n / g
+		t := MulMod(diff/g, p, step)
+		l := m * step
+		x = util.Mod(x+MulMod(m, t, l), l)
+		m = l
+	}
+	return x, m, true
+}
+
+func gcd[T constraints.Integer](a, b T) T {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func lcm[T constraints.Integer](a, b T) T {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return a / gcd(a, b) * b
+}
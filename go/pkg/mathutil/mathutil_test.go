@@ -0,0 +1,102 @@
+package mathutil
+
+import "testing"
+
+func TestGCDAndLCM(t *testing.T) {
+	testCases := []struct {
+		name     string
+		nums     []int
+		gcd, lcm int
+	}{
+		{name: "coprime", nums: []int{7, 13}, gcd: 1, lcm: 91},
+		{name: "common", nums: []int{12, 18}, gcd: 6, lcm: 36},
+		{name: "negative", nums: []int{-4, 6}, gcd: 2, lcm: 12},
+		{name: "zero", nums: []int{0, 5}, gcd: 5, lcm: 0},
+		{name: "many", nums: []int{4, 6, 10}, gcd: 2, lcm: 60},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if g := GCD(tc.nums[0], tc.nums[1], tc.nums[2:]...); g != tc.gcd {
+				t.Errorf("GCD(%v); expected: %d, actual: %d\n", tc.nums, tc.gcd, g)
+			}
+			if l := LCM(tc.nums[0], tc.nums[1], tc.nums[2:]...); l != tc.lcm {
+				t.Errorf("LCM(%v); expected: %d, actual: %d\n", tc.nums, tc.lcm, l)
+			}
+		})
+	}
+}
+
+func TestExtendedGCD(t *testing.T) {
+	for _, tc := range [][2]int{{240, 46}, {-15, 25}, {17, 0}, {0, -9}} {
+		a, b := tc[0], tc[1]
+		g, x, y := ExtendedGCD(a, b)
+		if g != GCD(a, b) || a*x+b*y != g {
+			t.Errorf("ExtendedGCD(%d, %d); unexpected result: %d, %d, %d\n", a, b, g, x, y)
+		}
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	if x, ok := ModInverse(3, 11); !ok || x != 4 {
+		t.Errorf("ModInverse(3, 11); expected: 4, actual: %d (%t)\n", x, ok)
+	}
+	if x, ok := ModInverse(-3, 11); !ok || x != 7 {
+		t.Errorf("ModInverse(-3, 11); expected: 7, actual: %d (%t)\n", x, ok)
+	}
+	if _, ok := ModInverse(6, 9); ok {
+		t.Error("ModInverse(6, 9); expected: false")
+	}
+}
+
+func TestModPow(t *testing.T) {
+	testCases := []struct {
+		base, exp, mod int
+		expected       int
+	}{
+		{base: 2, exp: 10, mod: 1000, expected: 24},
+		{base: 7, exp: 0, mod: 13, expected: 1},
+		{base: 5, exp: 3, mod: 1, expected: 0},
+		{base: -2, exp: 3, mod: 5, expected: 2},
+		// 2020/25 sample: the subject number 7 with loop size 8.
+		{base: 7, exp: 8, mod: 20201227, expected: 5764801},
+		{base: 3, exp: 1 << 40, mod: 1<<61 - 1, expected: 1131295851917031226},
+	}
+
+	for _, tc := range testCases {
+		if actual := ModPow(tc.base, tc.exp, tc.mod); actual != tc.expected {
+			t.Errorf("ModPow(%d, %d, %d); expected: %d, actual: %d\n", tc.base, tc.exp, tc.mod, tc.expected, actual)
+		}
+	}
+}
+
+func TestCRT(t *testing.T) {
+	testCases := []struct {
+		name       string
+		remainders []int
+		moduli     []int
+		x, m       int
+		ok         bool
+	}{
+		{
+			name:       "2020/13",
+			remainders: []int{0, -1, -4, -6, -7},
+			moduli:     []int{7, 13, 59, 31, 19},
+			x:          1068781,
+			m:          7 * 13 * 59 * 31 * 19,
+			ok:         true,
+		},
+		{name: "not coprime", remainders: []int{2, 4}, moduli: []int{6, 8}, x: 20, m: 24, ok: true},
+		{name: "no solution", remainders: []int{1, 2}, moduli: []int{4, 6}},
+		{name: "empty", x: 0, m: 1, ok: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x, m, ok := CRT(tc.remainders, tc.moduli)
+			if x != tc.x || m != tc.m || ok != tc.ok {
+				t.Errorf("\nexpected: %d, %d, %t\nactual: %d, %d, %t\n", tc.x, tc.m, tc.ok, x, m, ok)
+			}
+		})
+	}
+}
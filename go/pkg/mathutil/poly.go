@@ -0,0 +1,103 @@
+package mathutil
+
+import (
+	"math/big"
+	"slices"
+)
+
+// Extrapolate returns the value at index n of the sequence generated by the
+// polynomial of the lowest degree which passes through the given samples,
+// where ys[i] is the value at index i. With k samples, the polynomial is of
+// degree at most k-1, so three samples are enough for a quadratic sequence.
+//
+// The index n can be anywhere, including before the samples or far beyond
+// them. This uses Newton's forward difference formula, so the computation is
+// exact as long as the intermediate values fit in an int. It panics if there
+// are no samples.
+func Extrapolate(ys []int, n int) int {
+	if len(ys) == 0 {
+		panic("mathutil: no samples to extrapolate")
+	}
+	// diffs is the leading diagonal of the difference table, i.e., the
+	// forward differences of every order at index 0.
+	diffs := make([]int, 0, len(ys))
+	row := slices.Clone(ys)
+	for len(row) > 0 {
+		diffs = append(diffs, row[0])
+		for i := 0; i < len(row)-1; i++ {
+			row[i] = row[i+1] - row[i]
+		}
+		row = row[:len(row)-1]
+	}
+
+	// f(n) = Σ C(n, j) * Δ^j f(0), where the binomial coefficient is
+	// generalized for any integer n. The division is always exact as
+	// C(n, j-1) * (n-j+1) = C(n, j) * j.
+	value, binom := 0, 1
+	for j, d := range diffs {
+		if j > 0 {
+			binom = binom * (n - j + 1) / j
+		}
+		value += binom * d
+	}
+	return value
+}
+
+// ExtrapolateAt is similar to Extrapolate except that the samples are taken
+// at a regular cadence, where ys[i] is the value at start + i*step, and it
+// returns the value at x. This is useful for the puzzles which ask for the
+// value after a huge number of steps, when it grows as a polynomial for every
+// period of the input.
+//
+// The boolean is false if x is not one of the sample positions, i.e., it's
+// not separated from start by a multiple of step. It panics if step is zero.
+func ExtrapolateAt(start, step int, ys []int, x int) (int, bool) {
+	if step == 0 {
+		panic("mathutil: zero step for extrapolation")
+	}
+	if (x-start)%step != 0 {
+		return 0, false
+	}
+	return Extrapolate(ys, (x-start)/step), true
+}
+
+// Quadratic returns the value at index n of the quadratic sequence whose
+// first three values are y0, y1 and y2.
+func Quadratic(y0, y1, y2, n int) int {
+	return Extrapolate([]int{y0, y1, y2}, n)
+}
+
+// Lagrange returns the value at x of the polynomial of the lowest degree
+// which passes through the points (xs[i], ys[i]) using the Lagrange
+// interpolation. The points can be anywhere, unlike Extrapolate.
+//
+// The computation uses exact rational arithmetic. It returns false if the
+// value is not an integer. It panics if the slices are of different lengths,
+// are empty or the X coordinates are not distinct.
+func Lagrange(xs, ys []int, x int) (int, bool) {
+	if len(xs) != len(ys) {
+		panic("mathutil: xs and ys are of different lengths")
+	}
+	if len(xs) == 0 {
+		panic("mathutil: no points to interpolate")
+	}
+	sum := new(big.Rat)
+	for i, xi := range xs {
+		// The basis polynomial which is 1 at xi and 0 at every other xj.
+		term := new(big.Rat).SetInt64(int64(ys[i]))
+		for j, xj := range xs {
+			if i == j {
+				continue
+			}
+			if xi == xj {
+				panic("mathutil: duplicate x coordinate for interpolation")
+			}
+			term.Mul(term, big.NewRat(int64(x-xj), int64(xi-xj)))
+		}
+		sum.Add(sum, term)
+	}
+	if !sum.IsInt() || !sum.Num().IsInt64() {
+		return 0, false
+	}
+	return int(sum.Num().Int64()), true
+}
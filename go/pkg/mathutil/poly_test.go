@@ -0,0 +1,66 @@
+package mathutil
+
+import "testing"
+
+func TestExtrapolate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ys       []int
+		n        int
+		expected int
+	}{
+		{name: "constant", ys: []int{7}, n: 100, expected: 7},
+		{name: "linear", ys: []int{0, 3, 6, 9, 12, 15}, n: 6, expected: 18},
+		{name: "quadratic", ys: []int{1, 3, 6, 10, 15, 21}, n: 6, expected: 28},
+		{name: "cubic", ys: []int{10, 13, 16, 21, 30, 45}, n: 6, expected: 68},
+		{name: "backwards", ys: []int{10, 13, 16, 21, 30, 45}, n: -1, expected: 5},
+		{name: "huge", ys: []int{0, 1, 4}, n: 1_000_000, expected: 1_000_000_000_000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := Extrapolate(tc.ys, tc.n); actual != tc.expected {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestExtrapolateAt(t *testing.T) {
+	// f(x) = 2x² - 3x + 1 sampled at x = 5, 16, 27.
+	f := func(x int) int { return 2*x*x - 3*x + 1 }
+	ys := []int{f(5), f(16), f(27)}
+
+	if actual, ok := ExtrapolateAt(5, 11, ys, 5+11*1000); !ok || actual != f(5+11*1000) {
+		t.Errorf("\nexpected: %v (true)\nactual: %v (%t)\n", f(5+11*1000), actual, ok)
+	}
+	if _, ok := ExtrapolateAt(5, 11, ys, 6); ok {
+		t.Error("expected false for x outside the cadence")
+	}
+	if actual := Quadratic(ys[0], ys[1], ys[2], 2); actual != f(27) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", f(27), actual)
+	}
+}
+
+func TestLagrange(t *testing.T) {
+	testCases := []struct {
+		name       string
+		xs, ys     []int
+		x          int
+		expected   int
+		expectedOk bool
+	}{
+		{name: "irregular", xs: []int{-2, 1, 5}, ys: []int{4, 1, 25}, x: 10, expected: 100, expectedOk: true},
+		{name: "sample", xs: []int{-2, 1, 5}, ys: []int{4, 1, 25}, x: 1, expected: 1, expectedOk: true},
+		{name: "fraction", xs: []int{0, 2}, ys: []int{0, 1}, x: 1, expectedOk: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, ok := Lagrange(tc.xs, tc.ys, tc.x)
+			if actual != tc.expected || ok != tc.expectedOk {
+				t.Errorf("\nexpected: %v (%t)\nactual: %v (%t)\n", tc.expected, tc.expectedOk, actual, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package mathutil
+
+import (
+	"github.com/dhruvmanila/advent-of-code/go/pkg/matrix"
+	"github.com/dhruvmanila/advent-of-code/go/util"
+)
+
+// MatMulMod returns a new matrix which is the matrix product of a and b where
+// every element is reduced modulo m, i.e., it's in the range [0, m). The
+// intermediate values never overflow. It will panic if the number of columns
+// in a is not the same as the number of rows in b.
+func MatMulMod(a, b *matrix.Dense[int], m int) *matrix.Dense[int] {
+	return matrix.MulFunc(a, b, func(acc, x, y int) int {
+		return addMod(acc, MulMod(x, y, m), m)
+	})
+}
+
+// MatPowMod returns a new matrix which is the square matrix mat raised to the
+// power n where the matrices are multiplied using MatMulMod. This is the
+// matrix counterpart of ModPow and takes O(log n) multiplications. It will
+// panic if mat is not a square matrix or if n is negative.
+func MatPowMod(mat *matrix.Dense[int], n, m int) *matrix.Dense[int] {
+	return matrix.PowFunc(mat, n, func(a, b *matrix.Dense[int]) *matrix.Dense[int] {
+		return MatMulMod(a, b, m)
+	})
+}
+
+// LinearRecurrence returns the n-th term, starting from 0, of the sequence
+// defined by the linear recurrence
+//
+//	a(k) = coeffs[0]*a(k-1) + coeffs[1]*a(k-2) + ... + coeffs[d-1]*a(k-d)
+//
+// where the first d terms a(0), ..., a(d-1) are given by initial. The term is
+// computed by raising the companion matrix of the recurrence to a power close
+// to n, which takes O(d³ log n) time instead of the O(d n) for simulating it.
+//
+// It will panic if the number of coefficients and the initial terms are not
+// the same or if there are none of them.
+func LinearRecurrence(coeffs, initial []int, n int) int {
+	if n < len(initial) {
+		return initial[n]
+	}
+	m := matrix.Pow(companion(coeffs, initial), n-len(initial)+1)
+	term := 0
+	for j, v := range m.RawRowView(0) {
+		term += v * initial[len(initial)-1-j]
+	}
+	return term
+}
+
+// LinearRecurrenceMod is similar to LinearRecurrence except that the term is
+// reduced modulo m, for the sequences whose terms don't fit in an int.
+func LinearRecurrenceMod(coeffs, initial []int, n, m int) int {
+	if n < len(initial) {
+		return util.Mod(initial[n], m)
+	}
+	mat := MatPowMod(companion(coeffs, initial), n-len(initial)+1, m)
+	term := 0
+	for j, v := range mat.RawRowView(0) {
+		term = addMod(term, MulMod(v, initial[len(initial)-1-j], m), m)
+	}
+	return term
+}
+
+// companion returns the companion matrix of the linear recurrence which maps
+// the d most recent terms (a(k-1), ..., a(k-d)) to the next ones (a(k), ...,
+// a(k-d+1)). The first row computes the new term and the rest shift the
+// terms by one. So, starting from (a(d-1), ..., a(0)), the first element
+// after raising it to the power n-d+1 is a(n).
+func companion(coeffs, initial []int) *matrix.Dense[int] {
+	d := len(coeffs)
+	if d == 0 || d != len(initial) {
+		panic("mathutil: invalid number of coefficients or initial terms")
+	}
+	m := matrix.NewDense[int](d, d, nil)
+	m.SetRow(0, coeffs)
+	for i := 1; i < d; i++ {
+		m.Set(i, i-1, 1)
+	}
+	return m
+}
+
+// addMod returns a+b modulo m where both a and b are in [0, m), without
+// overflowing the intermediate sum.
+func addMod(a, b, m int) int {
+	if a >= m-b {
+		return a - (m - b)
+	}
+	return a + b
+}
@@ -0,0 +1,56 @@
+package mathutil
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/matrix"
+)
+
+func TestMatPowMod(t *testing.T) {
+	fib := matrix.NewDense(2, 2, []int{1, 1, 1, 0})
+	// F(1000) mod 1e9+7 is 517691607.
+	if actual := MatPowMod(fib, 999, 1_000_000_007).At(0, 0); actual != 517691607 {
+		t.Errorf("\nexpected: %v\nactual: %v\n", 517691607, actual)
+	}
+
+	m := matrix.NewDense(2, 2, []int{-1, 2, 3, -4})
+	if actual, expected := MatMulMod(m, m, 5), matrix.NewDense(2, 2, []int{2, 0, 0, 2}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("MatMulMod(m, m, 5)\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
+
+func TestLinearRecurrence(t *testing.T) {
+	testCases := []struct {
+		name     string
+		coeffs   []int
+		initial  []int
+		n        int
+		expected int
+	}{
+		{name: "initial", coeffs: []int{1, 1}, initial: []int{0, 1}, n: 1, expected: 1},
+		{name: "fibonacci", coeffs: []int{1, 1}, initial: []int{0, 1}, n: 50, expected: 12586269025},
+		{name: "tribonacci", coeffs: []int{1, 1, 1}, initial: []int{0, 0, 1}, n: 10, expected: 81},
+		{name: "geometric", coeffs: []int{3}, initial: []int{2}, n: 5, expected: 486},
+		// a(k) = a(k-7) + a(k-9) counts the lanternfish after k days starting
+		// from a single new fish with a timer of 8.
+		{name: "lanternfish", coeffs: []int{0, 0, 0, 0, 0, 0, 1, 0, 1}, initial: []int{1, 1, 1, 1, 1, 1, 1, 1, 1}, n: 18, expected: 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := LinearRecurrence(tc.coeffs, tc.initial, tc.n); actual != tc.expected {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLinearRecurrenceMod(t *testing.T) {
+	if actual := LinearRecurrenceMod([]int{1, 1}, []int{0, 1}, 1000, 1_000_000_007); actual != 517691607 {
+		t.Errorf("\nexpected: %v\nactual: %v\n", 517691607, actual)
+	}
+	if actual := LinearRecurrenceMod([]int{1}, []int{-3}, 0, 5); actual != 2 {
+		t.Errorf("\nexpected: %v\nactual: %v\n", 2, actual)
+	}
+}
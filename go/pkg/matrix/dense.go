@@ -1,5 +1,7 @@
 package matrix
 
+import "github.com/dhruvmanila/advent-of-code/go/util"
+
 // Dense is a generic dense matrix representation.
 type Dense[T any] struct {
 	// Rows and Cols are the total number of rows and columns in the matrix.
@@ -57,6 +59,39 @@ func (m *Dense[T]) At(i, j int) T {
 	return m.Data[i*m.Stride+j]
 }
 
+// AtOk returns the value of a matrix element at row i, column j. Unlike At,
+// this will not panic if i or j are out of bounds for the matrix, instead the
+// zero value for the type T is returned with the boolean set to false.
+func (m *Dense[T]) AtOk(i, j int) (v T, ok bool) {
+	if !m.inBounds(i, j) {
+		return v, false
+	}
+	return m.Data[i*m.Stride+j], true
+}
+
+// AtOr is similar to AtOk except that the fallback value is returned if i or
+// j are out of bounds for the matrix.
+func (m *Dense[T]) AtOr(i, j int, fallback T) T {
+	if !m.inBounds(i, j) {
+		return fallback
+	}
+	return m.Data[i*m.Stride+j]
+}
+
+// AtWrap returns the value of a matrix element at row i, column j where the
+// indices wrap around the matrix as if it were a torus. That is, the row
+// index is taken modulo the number of rows and the column index modulo the
+// number of columns, so negative indices are valid as well.
+func (m *Dense[T]) AtWrap(i, j int) T {
+	return m.Data[util.Mod(i, m.Rows)*m.Stride+util.Mod(j, m.Cols)]
+}
+
+// SetWrap is similar to Set except that the indices wrap around the matrix in
+// the same way as AtWrap.
+func (m *Dense[T]) SetWrap(i, j int, v T) {
+	m.Data[util.Mod(i, m.Rows)*m.Stride+util.Mod(j, m.Cols)] = v
+}
+
 // Set sets the element at row i, column j to the value v. It will panic if i
 // or j are out of bounds for the matrix.
 func (m *Dense[T]) Set(i, j int, v T) {
@@ -91,6 +126,33 @@ func (m *Dense[T]) AppendRow(src []T) {
 	m.Data = append(m.Data, src...)
 }
 
+// AppendCol appends a new column at the end of the matrix with the values in
+// src. If the receiver is empty, as determined by the `IsEmpty()` method, then
+// it will be initialized as per the given src. It will panic if len(src) is
+// not equal to the number of rows in a non-empty receiver.
+//
+// As the data is stored in row-major order, this will allocate a new backing
+// slice for the receiver.
+func (m *Dense[T]) AppendCol(src []T) {
+	switch {
+	case m.IsEmpty():
+		m.Rows = len(src)
+	case len(src) != m.Rows:
+		panic(ErrColLength)
+	}
+	cols := m.Cols + 1
+	data := make([]T, 0, m.Rows*cols)
+	for i := 0; i < m.Rows; i++ {
+		if m.Cols > 0 {
+			data = append(data, m.RawRowView(i)...)
+		}
+		data = append(data, src[i])
+	}
+	m.Cols = cols
+	m.Stride = cols
+	m.Data = data
+}
+
 // RowView returns row i of the matrix data represented as a column vector,
 // backed by the matrix data. It will panic if i is out of bounds for the matrix.
 func (m *Dense[T]) RowView(i int) *VecDense[T] {
@@ -131,6 +193,27 @@ func (m *Dense[T]) ColView(j int) *VecDense[T] {
 	return &v
 }
 
+// Diag returns a Vector reflecting the main diagonal of the matrix, backed by
+// the matrix data. The length of the vector is the minimum of the number of
+// rows and columns.
+func (m *Dense[T]) Diag() *VecDense[T] {
+	n := min(m.Rows, m.Cols)
+	return &VecDense[T]{
+		N:    n,
+		Inc:  m.Stride + 1,
+		Data: m.Data[:(n-1)*(m.Stride+1)+1],
+	}
+}
+
+// SetDiag sets the values in the main diagonal of the matrix to the values in
+// src. len(src) must equal the minimum of the number of rows and columns.
+func (m *Dense[T]) SetDiag(src []T) {
+	if len(src) != min(m.Rows, m.Cols) {
+		panic(ErrVectorLength)
+	}
+	vectorCopy(VecDense[T]{N: len(src), Inc: 1, Data: src}, *m.Diag())
+}
+
 // SliceRow returns a slice of the specified row `r` from `start` (inclusive)
 // upto `stop` (exclusive). The same rule applies for the slice parameters as
 // governed by the language except this requires both the start and stop index.
@@ -147,20 +230,189 @@ func (m *Dense[T]) SliceRow(r, start, stop int) []T {
 	return m.Data[r*m.Stride+start : r*m.Stride+stop]
 }
 
+// Apply sets every element of the matrix to the value returned by calling fn
+// with the row i, column j and the current value v of that element.
+func (m *Dense[T]) Apply(fn func(i, j int, v T) T) {
+	for i := 0; i < m.Rows; i++ {
+		row := m.RawRowView(i)
+		for j, v := range row {
+			row[j] = fn(i, j, v)
+		}
+	}
+}
+
+// Fill sets every element of the matrix to the value v.
+func (m *Dense[T]) Fill(v T) {
+	for i := 0; i < m.Rows; i++ {
+		row := m.RawRowView(i)
+		for j := range row {
+			row[j] = v
+		}
+	}
+}
+
+// CopyFrom copies the elements of other into the receiver. It will panic if
+// the dimensions of both the matrices are not the same.
+func (m *Dense[T]) CopyFrom(other *Dense[T]) {
+	if m.Rows != other.Rows || m.Cols != other.Cols {
+		panic(ErrShape)
+	}
+	for i := 0; i < m.Rows; i++ {
+		copy(m.RawRowView(i), other.RawRowView(i))
+	}
+}
+
+// Equal returns true if the receiver and other have the same dimensions and
+// every pair of corresponding elements are equal as reported by eq. For a
+// comparable type, operator.Eq can be used as eq.
+func (m *Dense[T]) Equal(other *Dense[T], eq func(a, b T) bool) bool {
+	if m.Rows != other.Rows || m.Cols != other.Cols {
+		return false
+	}
+	_, _, found := m.Diff(other, eq)
+	return !found
+}
+
+// Diff returns the row i and column j of the first element, in row-major
+// order, which differs between the receiver and other as reported by eq. The
+// boolean is false if there is no such element. It will panic if the
+// dimensions of both the matrices are not the same.
+func (m *Dense[T]) Diff(other *Dense[T], eq func(a, b T) bool) (i, j int, found bool) {
+	if m.Rows != other.Rows || m.Cols != other.Cols {
+		panic(ErrShape)
+	}
+	for i = 0; i < m.Rows; i++ {
+		row, otherRow := m.RawRowView(i), other.RawRowView(i)
+		for j = range row {
+			if !eq(row[j], otherRow[j]) {
+				return i, j, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// Slice returns a new matrix that shares the backing data with the receiver
+// containing the rows i0 (inclusive) upto i1 (exclusive) and the columns j0
+// (inclusive) upto j1 (exclusive). Changes to the elements of the returned
+// matrix will be reflected in the receiver and vice versa.
+//
+// The returned matrix has the same stride as the receiver, so it should not be
+// grown using AppendRow. It will panic if the indices are out of bounds for
+// the receiver or if i0 >= i1 or j0 >= j1.
+func (m *Dense[T]) Slice(i0, i1, j0, j1 int) *Dense[T] {
+	if i0 < 0 || i0 >= i1 || i1 > m.Rows || j0 < 0 || j0 >= j1 || j1 > m.Cols {
+		panic(ErrSliceBounds)
+	}
+	return &Dense[T]{
+		Rows:   i1 - i0,
+		Cols:   j1 - j0,
+		Stride: m.Stride,
+		Data:   m.Data[i0*m.Stride+j0 : (i1-1)*m.Stride+j1],
+	}
+}
+
+// Find returns the row i and column j of the first element, in row-major
+// order, for which pred returns true. The boolean is false if there is no
+// such element.
+func (m *Dense[T]) Find(pred func(v T) bool) (i, j int, ok bool) {
+	for idx, v := range m.All() {
+		if pred(v) {
+			return idx[0], idx[1], true
+		}
+	}
+	return 0, 0, false
+}
+
+// FindAll returns the index pairs {i, j} of all the elements, in row-major
+// order, for which pred returns true.
+func (m *Dense[T]) FindAll(pred func(v T) bool) [][2]int {
+	var indices [][2]int
+	for idx, v := range m.All() {
+		if pred(v) {
+			indices = append(indices, idx)
+		}
+	}
+	return indices
+}
+
+// Count returns the number of elements for which pred returns true.
+func (m *Dense[T]) Count(pred func(v T) bool) int {
+	n := 0
+	for _, v := range m.All() {
+		if pred(v) {
+			n++
+		}
+	}
+	return n
+}
+
+// Flatten returns a new slice containing all the elements of the matrix in
+// row-major order. Unlike the Data field, this only contains the elements
+// which are part of the matrix if the receiver is a view created by Slice.
+func (m *Dense[T]) Flatten() []T {
+	data := make([]T, 0, m.Rows*m.Cols)
+	for i := 0; i < m.Rows; i++ {
+		data = append(data, m.RawRowView(i)...)
+	}
+	return data
+}
+
+// Reshape changes the dimensions of the matrix to r rows and c columns while
+// keeping the elements in the same row-major order. It will panic if r*c is
+// not equal to the number of elements in the matrix.
+//
+// If the receiver is a view created by Slice, the elements are first copied
+// into a new backing slice, so the receiver will no longer share the data.
+func (m *Dense[T]) Reshape(r, c int) {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if r*c != m.Rows*m.Cols {
+		panic(ErrShape)
+	}
+	if m.Stride != m.Cols {
+		m.Data = m.Flatten()
+	}
+	m.Rows, m.Cols, m.Stride = r, c, c
+	m.Data = m.Data[:r*c]
+}
+
 // IsEmpty returns whether the receiver is empty.
 func (m *Dense[T]) IsEmpty() bool {
 	return m.Stride == 0
 }
 
-// Copy returns a copy of the receiver matrix.
+// Copy returns a deep copy of the receiver matrix. The returned matrix does not
+// share the backing data with the receiver. If the receiver is a view created
+// by Slice, only the elements of the view are copied.
 func (m *Dense[T]) Copy() *Dense[T] {
-	data := make([]T, 0, len(m.Data))
-	copy(data, m.Data)
 	return &Dense[T]{
 		Rows:   m.Rows,
 		Cols:   m.Cols,
-		Stride: m.Stride,
-		Data:   data,
+		Stride: m.Cols,
+		Data:   m.Flatten(),
+	}
+}
+
+// CloneInto copies the receiver matrix into dst, changing the dimensions of
+// dst to match the receiver. The backing slice of dst is reused if it has
+// enough capacity, otherwise a new one is allocated.
+//
+// This is useful for simulations which alternate between two matrices for
+// the current and the next state without allocating on every step.
+func (m *Dense[T]) CloneInto(dst *Dense[T]) {
+	n := m.Rows * m.Cols
+	if cap(dst.Data) < n {
+		dst.Data = make([]T, n)
+	}
+	dst.Rows, dst.Cols, dst.Stride = m.Rows, m.Cols, m.Cols
+	dst.Data = dst.Data[:n]
+	for i := 0; i < m.Rows; i++ {
+		copy(dst.RawRowView(i), m.RawRowView(i))
 	}
 }
 
@@ -169,6 +421,23 @@ func (m *Dense[T]) T() Matrix[T] {
 	return Transpose[T]{Matrix: m}
 }
 
+// TCopy returns a new matrix which is the transpose of the receiver. Unlike T,
+// the elements are copied into a new backing slice in row-major order of
+// the transposed matrix.
+func (m *Dense[T]) TCopy() *Dense[T] {
+	t := NewDense[T](m.Cols, m.Rows, nil)
+	for i := 0; i < m.Rows; i++ {
+		for j, v := range m.RawRowView(i) {
+			t.Data[j*t.Stride+i] = v
+		}
+	}
+	return t
+}
+
+func (m *Dense[T]) inBounds(i, j int) bool {
+	return 0 <= i && i < m.Rows && 0 <= j && j < m.Cols
+}
+
 func (m *Dense[T]) checkBounds(i, j int) {
 	if i >= m.Rows || i < 0 {
 		panic(ErrRowAccess)
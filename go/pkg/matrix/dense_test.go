@@ -0,0 +1,221 @@
+package matrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDenseApplyAndFill(t *testing.T) {
+	m := NewDense[int](2, 2, nil)
+
+	m.Fill(3)
+	if expected := []int{3, 3, 3, 3}; !reflect.DeepEqual(m.Data, expected) {
+		t.Errorf("m.Fill(3)\nexpected: %v\nactual: %v\n", expected, m.Data)
+	}
+
+	m.Apply(func(i, j, v int) int { return v + i*10 + j })
+	if expected := []int{3, 4, 13, 14}; !reflect.DeepEqual(m.Data, expected) {
+		t.Errorf("m.Apply()\nexpected: %v\nactual: %v\n", expected, m.Data)
+	}
+
+	n := NewDense[int](2, 2, nil)
+	n.CopyFrom(m)
+	if !reflect.DeepEqual(n, m) {
+		t.Errorf("n.CopyFrom(m)\nexpected: %v\nactual: %v\n", m, n)
+	}
+}
+
+func TestDenseEqualAndDiff(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+	m := NewDense(2, 2, []int{1, 2, 3, 4})
+
+	if !m.Equal(NewDense(2, 2, []int{1, 2, 3, 4}), eq) {
+		t.Error("m.Equal(); expected equal matrices")
+	}
+	if m.Equal(NewDense(1, 4, []int{1, 2, 3, 4}), eq) {
+		t.Error("m.Equal(); expected matrices with different shape to be unequal")
+	}
+
+	if i, j, found := m.Diff(NewDense(2, 2, []int{1, 2, 0, 0}), eq); !found || i != 1 || j != 0 {
+		t.Errorf("m.Diff(); expected: (1, 0, true), actual: (%d, %d, %v)\n", i, j, found)
+	}
+	if _, _, found := m.Diff(m, eq); found {
+		t.Error("m.Diff(m); expected no difference")
+	}
+}
+
+func TestDenseSlice(t *testing.T) {
+	// 1 2 3 4
+	// 5 6 7 8
+	// 9 0 1 2
+	m := NewDense(3, 4, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 0, 1, 2})
+
+	s := m.Slice(1, 3, 1, 3)
+	if r, c := s.Dims(); r != 2 || c != 2 {
+		t.Fatalf("m.Slice(1, 3, 1, 3).Dims(); expected: (2, 2), actual: (%d, %d)\n", r, c)
+	}
+	actual := [][]int{s.RawRowView(0), s.RawRowView(1)}
+	if expected := [][]int{{6, 7}, {0, 1}}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("m.Slice(1, 3, 1, 3)\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	s.Set(1, 1, 42)
+	if v := m.At(2, 2); v != 42 {
+		t.Errorf("view does not share data; expected: 42, actual: %d\n", v)
+	}
+
+	defer func() {
+		if r := recover(); r != ErrSliceBounds {
+			t.Errorf("m.Slice(0, 4, 0, 1); expected panic: %v, actual: %v\n", ErrSliceBounds, r)
+		}
+	}()
+	m.Slice(0, 4, 0, 1)
+}
+
+func TestDenseTCopy(t *testing.T) {
+	m := NewDense(2, 3, []int{1, 2, 3, 4, 5, 6})
+	expected := NewDense(3, 2, []int{1, 4, 2, 5, 3, 6})
+
+	if actual := m.TCopy(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("m.TCopy()\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if actual := m.T().(Transpose[int]).Materialize(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("m.T().Materialize()\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if actual := m.Slice(0, 2, 1, 3).TCopy(); !reflect.DeepEqual(actual, NewDense(2, 2, []int{2, 5, 3, 6})) {
+		t.Errorf("m.Slice(0, 2, 1, 3).TCopy(); actual: %v\n", actual)
+	}
+}
+
+func TestDenseFindAndCount(t *testing.T) {
+	m := FromLines([]string{"S.#", "#.E"})
+	isWall := func(v byte) bool { return v == '#' }
+
+	if i, j, ok := m.Find(func(v byte) bool { return v == 'E' }); !ok || i != 1 || j != 2 {
+		t.Errorf("m.Find('E'); expected: (1, 2, true), actual: (%d, %d, %v)\n", i, j, ok)
+	}
+	if _, _, ok := m.Find(func(v byte) bool { return v == 'X' }); ok {
+		t.Error("m.Find('X'); expected not found")
+	}
+	if actual, expected := m.FindAll(isWall), [][2]int{{0, 2}, {1, 0}}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("m.FindAll('#')\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if n := m.Count(isWall); n != 2 {
+		t.Errorf("m.Count('#'); expected: 2, actual: %d\n", n)
+	}
+}
+
+func TestDenseAppendCol(t *testing.T) {
+	var m Dense[int]
+	m.AppendCol([]int{1, 4})
+	m.AppendCol([]int{2, 5})
+	m.AppendCol([]int{3, 6})
+
+	if expected := NewDense(2, 3, []int{1, 2, 3, 4, 5, 6}); !reflect.DeepEqual(&m, expected) {
+		t.Errorf("m.AppendCol()\nexpected: %v\nactual: %v\n", expected, &m)
+	}
+}
+
+func TestDenseDiag(t *testing.T) {
+	m := NewDense(2, 3, []int{1, 2, 3, 4, 5, 6})
+
+	var diag []int
+	for _, v := range m.Diag().All() {
+		diag = append(diag, v)
+	}
+	if expected := []int{1, 5}; !reflect.DeepEqual(diag, expected) {
+		t.Errorf("m.Diag()\nexpected: %v\nactual: %v\n", expected, diag)
+	}
+
+	m.SetDiag([]int{0, 0})
+	if expected := []int{0, 2, 3, 4, 0, 6}; !reflect.DeepEqual(m.Data, expected) {
+		t.Errorf("m.SetDiag()\nexpected: %v\nactual: %v\n", expected, m.Data)
+	}
+}
+
+func TestDenseReshapeAndFlatten(t *testing.T) {
+	m := NewDense(3, 3, []int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+
+	view := m.Slice(1, 3, 0, 2)
+	if actual, expected := view.Flatten(), []int{4, 5, 7, 8}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("view.Flatten()\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	view.Reshape(1, 4)
+	if expected := NewDense(1, 4, []int{4, 5, 7, 8}); !reflect.DeepEqual(view, expected) {
+		t.Errorf("view.Reshape(1, 4)\nexpected: %v\nactual: %v\n", expected, view)
+	}
+
+	m.Reshape(9, 1)
+	if r, c := m.Dims(); r != 9 || c != 1 || m.At(4, 0) != 5 {
+		t.Errorf("m.Reshape(9, 1); actual dims: (%d, %d), m.At(4, 0): %d\n", r, c, m.At(4, 0))
+	}
+}
+
+func TestDenseCopy(t *testing.T) {
+	m := NewDense(2, 2, []int{1, 2, 3, 4})
+
+	c := m.Copy()
+	c.Set(0, 0, 9)
+	if !reflect.DeepEqual(c, NewDense(2, 2, []int{9, 2, 3, 4})) || m.At(0, 0) != 1 {
+		t.Errorf("m.Copy() is not a deep copy\nm: %v\ncopy: %v\n", m, c)
+	}
+
+	if actual, expected := m.Slice(0, 2, 1, 2).Copy(), NewDense(2, 1, []int{2, 4}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("view.Copy()\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	dst := NewDense[int](3, 3, nil)
+	data := dst.Data
+	m.CloneInto(dst)
+	if !reflect.DeepEqual(dst, m) {
+		t.Errorf("m.CloneInto(dst)\nexpected: %v\nactual: %v\n", m, dst)
+	}
+	if &dst.Data[0] != &data[0] {
+		t.Error("m.CloneInto(dst) did not reuse the backing slice")
+	}
+}
+
+func TestDenseAtOk(t *testing.T) {
+	m := NewDense(2, 2, []int{1, 2, 3, 4})
+
+	if v, ok := m.AtOk(1, 0); !ok || v != 3 {
+		t.Errorf("m.AtOk(1, 0); expected: (3, true), actual: (%d, %v)\n", v, ok)
+	}
+	for _, idx := range [][2]int{{-1, 0}, {0, -1}, {2, 0}, {0, 2}} {
+		if v, ok := m.AtOk(idx[0], idx[1]); ok || v != 0 {
+			t.Errorf("m.AtOk(%d, %d); expected: (0, false), actual: (%d, %v)\n", idx[0], idx[1], v, ok)
+		}
+		if v := m.AtOr(idx[0], idx[1], -1); v != -1 {
+			t.Errorf("m.AtOr(%d, %d, -1); expected: -1, actual: %d\n", idx[0], idx[1], v)
+		}
+	}
+	if v := m.AtOr(0, 1, -1); v != 2 {
+		t.Errorf("m.AtOr(0, 1, -1); expected: 2, actual: %d\n", v)
+	}
+}
+
+func TestDenseAtWrap(t *testing.T) {
+	m := NewDense(2, 3, []int{1, 2, 3, 4, 5, 6})
+
+	testCases := []struct {
+		i, j     int
+		expected int
+	}{
+		{0, 0, 1},
+		{2, 3, 1},
+		{-1, -1, 6},
+		{5, -4, 6},
+		{-3, 7, 5},
+	}
+	for _, tc := range testCases {
+		if v := m.AtWrap(tc.i, tc.j); v != tc.expected {
+			t.Errorf("m.AtWrap(%d, %d); expected: %d, actual: %d\n", tc.i, tc.j, tc.expected, v)
+		}
+	}
+
+	m.SetWrap(-1, 4, 0)
+	if v := m.At(1, 1); v != 0 {
+		t.Errorf("m.SetWrap(-1, 4, 0); expected m.At(1, 1): 0, actual: %d\n", v)
+	}
+}
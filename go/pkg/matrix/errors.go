@@ -11,4 +11,6 @@ var (
 	ErrRowLength         = errors.New("matrix: row length mismatch")
 	ErrColLength         = errors.New("matrix: column length mismatch")
 	ErrVectorLength      = errors.New("matrix: vector length mismatch")
+	ErrSliceBounds       = errors.New("matrix: slice index out of range")
+	ErrInvalidDigit      = errors.New("matrix: invalid digit")
 )
@@ -0,0 +1,51 @@
+package matrix
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format returns the string representation of the matrix where every element
+// is rendered using the given function. The elements of a row are joined
+// together without any separator and the rows are separated by a newline.
+func (m *Dense[T]) Format(render func(v T) string) string {
+	return m.FormatFunc(func(_, _ int, v T) string {
+		return render(v)
+	})
+}
+
+// FormatFunc is similar to Format except that the render function is also
+// given the row i and column j of the element. This can be used to highlight
+// specific elements, like a path through the grid.
+func (m *Dense[T]) FormatFunc(render func(i, j int, v T) string) string {
+	var b strings.Builder
+	for i := 0; i < m.Rows; i++ {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		for j, v := range m.RawRowView(i) {
+			b.WriteString(render(i, j, v))
+		}
+	}
+	return b.String()
+}
+
+// String returns the string representation of the matrix. A matrix of bytes or
+// runes is rendered as a grid of the respective characters while for any other
+// type, the elements of a row are formatted with the %v verb and separated by
+// a space. Use Format to customize the rendering.
+func (m *Dense[T]) String() string {
+	return m.FormatFunc(func(_, j int, v T) string {
+		switch v := any(v).(type) {
+		case byte:
+			return string(v)
+		case rune:
+			return string(v)
+		default:
+			if j > 0 {
+				return fmt.Sprintf(" %v", v)
+			}
+			return fmt.Sprint(v)
+		}
+	})
+}
@@ -0,0 +1,40 @@
+package matrix
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDenseString(t *testing.T) {
+	testCases := []struct {
+		name     string
+		matrix   fmt.Stringer
+		expected string
+	}{
+		{name: "bytes", matrix: FromLines([]string{"#.", ".#"}), expected: "#.\n.#"},
+		{name: "runes", matrix: FromRunes([]string{"→↓", "↑←"}), expected: "→↓\n↑←"},
+		{name: "ints", matrix: NewDense(2, 2, []int{1, 23, 4, 5}), expected: "1 23\n4 5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.matrix.String(); actual != tc.expected {
+				t.Errorf("\nexpected: %q\nactual: %q\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDenseFormatFunc(t *testing.T) {
+	m := FromDigitLines([]string{"12", "34"})
+
+	actual := m.FormatFunc(func(i, j, v int) string {
+		if i == j {
+			return fmt.Sprintf("[%d]", v)
+		}
+		return fmt.Sprint(v)
+	})
+	if expected := "[1]2\n3[4]"; actual != expected {
+		t.Errorf("\nexpected: %q\nactual: %q\n", expected, actual)
+	}
+}
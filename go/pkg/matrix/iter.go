@@ -0,0 +1,55 @@
+package matrix
+
+import "iter"
+
+// All returns an iterator over the index pairs {i, j} and the respective
+// values of all the elements of the matrix in row-major order.
+func (m *Dense[T]) All() iter.Seq2[[2]int, T] {
+	return func(yield func([2]int, T) bool) {
+		for i := 0; i < m.Rows; i++ {
+			for j, v := range m.RawRowView(i) {
+				if !yield([2]int{i, j}, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// RowsIter returns an iterator over the row indices and the respective rows
+// of the matrix from top to bottom. Every row is a slice backed by the matrix
+// data as returned by RawRowView.
+func (m *Dense[T]) RowsIter() iter.Seq2[int, []T] {
+	return func(yield func(int, []T) bool) {
+		for i := 0; i < m.Rows; i++ {
+			if !yield(i, m.RawRowView(i)) {
+				return
+			}
+		}
+	}
+}
+
+// ColsIter returns an iterator over the column indices and the respective
+// columns of the matrix from left to right. Every column is a vector backed
+// by the matrix data as returned by ColView.
+func (m *Dense[T]) ColsIter() iter.Seq2[int, *VecDense[T]] {
+	return func(yield func(int, *VecDense[T]) bool) {
+		for j := 0; j < m.Cols; j++ {
+			if !yield(j, m.ColView(j)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and the respective values of all
+// the elements of the vector.
+func (v *VecDense[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < v.N; i++ {
+			if !yield(i, v.Data[i*v.Inc]) {
+				return
+			}
+		}
+	}
+}
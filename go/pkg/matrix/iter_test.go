@@ -0,0 +1,43 @@
+package matrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDenseIterators(t *testing.T) {
+	m := NewDense(2, 2, []int{1, 2, 3, 4})
+
+	var indices [][2]int
+	var values []int
+	for idx, v := range m.All() {
+		indices = append(indices, idx)
+		values = append(values, v)
+	}
+	if expected := [][2]int{{0, 0}, {0, 1}, {1, 0}, {1, 1}}; !reflect.DeepEqual(indices, expected) {
+		t.Errorf("m.All() indices\nexpected: %v\nactual: %v\n", expected, indices)
+	}
+	if expected := []int{1, 2, 3, 4}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("m.All() values\nexpected: %v\nactual: %v\n", expected, values)
+	}
+
+	var rows [][]int
+	for _, row := range m.RowsIter() {
+		rows = append(rows, row)
+	}
+	if expected := [][]int{{1, 2}, {3, 4}}; !reflect.DeepEqual(rows, expected) {
+		t.Errorf("m.RowsIter()\nexpected: %v\nactual: %v\n", expected, rows)
+	}
+
+	var cols [][]int
+	for _, col := range m.ColsIter() {
+		var values []int
+		for _, v := range col.All() {
+			values = append(values, v)
+		}
+		cols = append(cols, values)
+	}
+	if expected := [][]int{{1, 3}, {2, 4}}; !reflect.DeepEqual(cols, expected) {
+		t.Errorf("m.ColsIter()\nexpected: %v\nactual: %v\n", expected, cols)
+	}
+}
@@ -53,3 +53,20 @@ func (t Transpose[T]) Set(i, j int, v T) {
 func (t Transpose[T]) T() Matrix[T] {
 	return t.Matrix
 }
+
+// Materialize returns a new Dense matrix containing the elements of the
+// transposed matrix. Changes to the returned matrix are not reflected in
+// the Matrix field.
+func (t Transpose[T]) Materialize() *Dense[T] {
+	if m, ok := t.Matrix.(*Dense[T]); ok {
+		return m.TCopy()
+	}
+	r, c := t.Dims()
+	m := NewDense[T](r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.Data[i*m.Stride+j] = t.At(i, j)
+		}
+	}
+	return m
+}
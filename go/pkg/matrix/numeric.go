@@ -0,0 +1,143 @@
+package matrix
+
+import "golang.org/x/exp/constraints"
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Sum returns the sum of all the elements in the matrix m.
+func Sum[T Number](m *Dense[T]) T {
+	var total T
+	for _, v := range m.All() {
+		total += v
+	}
+	return total
+}
+
+// Max returns the maximum element in the matrix m.
+func Max[T Number](m *Dense[T]) T {
+	result := m.At(0, 0)
+	for _, v := range m.All() {
+		result = max(result, v)
+	}
+	return result
+}
+
+// Min returns the minimum element in the matrix m.
+func Min[T Number](m *Dense[T]) T {
+	result := m.At(0, 0)
+	for _, v := range m.All() {
+		result = min(result, v)
+	}
+	return result
+}
+
+// AddScalar returns a new matrix with the value v added to every element of
+// the matrix m.
+func AddScalar[T Number](m *Dense[T], v T) *Dense[T] {
+	return mapNew(m, func(x T) T { return x + v })
+}
+
+// MulScalar returns a new matrix with every element of the matrix m
+// multiplied by the value v.
+func MulScalar[T Number](m *Dense[T], v T) *Dense[T] {
+	return mapNew(m, func(x T) T { return x * v })
+}
+
+// Add returns a new matrix which is the element-wise sum of a and b. It will
+// panic if the dimensions of both the matrices are not the same.
+func Add[T Number](a, b *Dense[T]) *Dense[T] {
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		panic(ErrShape)
+	}
+	n := NewDense[T](a.Rows, a.Cols, nil)
+	for i := 0; i < a.Rows; i++ {
+		dst, rb := n.RawRowView(i), b.RawRowView(i)
+		for j, v := range a.RawRowView(i) {
+			dst[j] = v + rb[j]
+		}
+	}
+	return n
+}
+
+// Identity returns a new n x n identity matrix.
+func Identity[T Number](n int) *Dense[T] {
+	m := NewDense[T](n, n, nil)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, 1)
+	}
+	return m
+}
+
+// Mul returns a new matrix which is the matrix product of a and b. It will
+// panic if the number of columns in a is not the same as the number of rows
+// in b.
+func Mul[T Number](a, b *Dense[T]) *Dense[T] {
+	return MulFunc(a, b, func(acc, x, y T) T { return acc + x*y })
+}
+
+// Pow returns a new matrix which is the square matrix m raised to the power
+// n using exponentiation by squaring, which takes O(log n) multiplications.
+// It will panic if m is not a square matrix or if n is negative.
+func Pow[T Number](m *Dense[T], n int) *Dense[T] {
+	return PowFunc(m, n, Mul[T])
+}
+
+// MulFunc is similar to Mul except that every element of the product is
+// accumulated using fma, which returns acc plus the product of x and y. This
+// allows computing the product with a custom arithmetic, like the modular
+// one.
+func MulFunc[T Number](a, b *Dense[T], fma func(acc, x, y T) T) *Dense[T] {
+	if a.Cols != b.Rows {
+		panic(ErrShape)
+	}
+	n := NewDense[T](a.Rows, b.Cols, nil)
+	for i := 0; i < a.Rows; i++ {
+		dst := n.RawRowView(i)
+		for k, x := range a.RawRowView(i) {
+			if x == 0 {
+				continue
+			}
+			for j, y := range b.RawRowView(k) {
+				dst[j] = fma(dst[j], x, y)
+			}
+		}
+	}
+	return n
+}
+
+// PowFunc is similar to Pow except that the matrices are multiplied using
+// mul, starting from the identity matrix.
+func PowFunc[T Number](m *Dense[T], n int, mul func(a, b *Dense[T]) *Dense[T]) *Dense[T] {
+	if m.Rows != m.Cols {
+		panic(ErrShape)
+	}
+	if n < 0 {
+		panic("matrix: negative exponent")
+	}
+	result := Identity[T](m.Rows)
+	base := m
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result = mul(result, base)
+		}
+		if n > 1 {
+			base = mul(base, base)
+		}
+	}
+	return result
+}
+
+// mapNew returns a new matrix with fn applied to every element of m.
+func mapNew[T any](m *Dense[T], fn func(v T) T) *Dense[T] {
+	n := NewDense[T](m.Rows, m.Cols, nil)
+	for i := 0; i < m.Rows; i++ {
+		dst := n.RawRowView(i)
+		for j, v := range m.RawRowView(i) {
+			dst[j] = fn(v)
+		}
+	}
+	return n
+}
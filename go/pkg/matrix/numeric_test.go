@@ -0,0 +1,57 @@
+package matrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNumeric(t *testing.T) {
+	m := NewDense(2, 2, []int{3, -1, 4, 1})
+
+	if s := Sum(m); s != 7 {
+		t.Errorf("Sum(m); expected: 7, actual: %d\n", s)
+	}
+	if v := Max(m); v != 4 {
+		t.Errorf("Max(m); expected: 4, actual: %d\n", v)
+	}
+	if v := Min(m); v != -1 {
+		t.Errorf("Min(m); expected: -1, actual: %d\n", v)
+	}
+	if actual, expected := AddScalar(m, 2), NewDense(2, 2, []int{5, 1, 6, 3}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("AddScalar(m, 2)\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if actual, expected := MulScalar(m, 2), NewDense(2, 2, []int{6, -2, 8, 2}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("MulScalar(m, 2)\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if actual, expected := Add(m, m), NewDense(2, 2, []int{6, -2, 8, 2}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Add(m, m)\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
+
+func TestMulAndPow(t *testing.T) {
+	a := NewDense(2, 3, []int{1, 2, 3, 4, 5, 6})
+	b := NewDense(3, 2, []int{7, 8, 9, 10, 11, 12})
+	if actual, expected := Mul(a, b), NewDense(2, 2, []int{58, 64, 139, 154}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Mul(a, b)\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	fib := NewDense(2, 2, []int{1, 1, 1, 0})
+	testCases := []struct {
+		name     string
+		n        int
+		expected *Dense[int]
+	}{
+		{name: "zero", n: 0, expected: Identity[int](2)},
+		{name: "one", n: 1, expected: fib},
+		{name: "ten", n: 10, expected: NewDense(2, 2, []int{89, 55, 55, 34})},
+		{name: "ninety", n: 90, expected: NewDense(2, 2, []int{4660046610375530309, 2880067194370816120, 2880067194370816120, 1779979416004714189})},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := Pow(fib, tc.n); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package matrix
+
+// FromLines creates a new Dense matrix from the given lines where every byte
+// of a line is an element of the respective row. It will panic if the lines
+// are not of equal length or if there are no lines.
+func FromLines(lines []string) *Dense[byte] {
+	return fromLines(lines, func(line string) []byte {
+		return []byte(line)
+	})
+}
+
+// FromRunes is similar to FromLines except that every rune of a line is an
+// element of the respective row.
+func FromRunes(lines []string) *Dense[rune] {
+	return fromLines(lines, func(line string) []rune {
+		return []rune(line)
+	})
+}
+
+// FromDigitLines is similar to FromLines except that every byte of a line is
+// expected to be a decimal digit which is converted to its integer value. It
+// will panic with ErrInvalidDigit if any other character is encountered.
+func FromDigitLines(lines []string) *Dense[int] {
+	return fromLines(lines, func(line string) []int {
+		digits := make([]int, len(line))
+		for i := 0; i < len(line); i++ {
+			if line[i] < '0' || line[i] > '9' {
+				panic(ErrInvalidDigit)
+			}
+			digits[i] = int(line[i] - '0')
+		}
+		return digits
+	})
+}
+
+// fromLines creates a new Dense matrix from the given lines where every line
+// is converted to a row using the convert function.
+func fromLines[T any](lines []string, convert func(line string) []T) *Dense[T] {
+	if len(lines) == 0 {
+		panic(ErrZeroLength)
+	}
+	var m Dense[T]
+	for _, line := range lines {
+		m.AppendRow(convert(line))
+	}
+	if m.Cols == 0 {
+		panic(ErrZeroLength)
+	}
+	return &m
+}
@@ -0,0 +1,27 @@
+package matrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFromLines(t *testing.T) {
+	lines := []string{"123", "456"}
+
+	if actual, expected := FromLines(lines), NewDense(2, 3, []byte("123456")); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FromLines()\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if actual, expected := FromRunes(lines), NewDense(2, 3, []rune("123456")); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FromRunes()\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if actual, expected := FromDigitLines(lines), NewDense(2, 3, []int{1, 2, 3, 4, 5, 6}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("FromDigitLines()\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	defer func() {
+		if r := recover(); r != ErrRowLength {
+			t.Errorf("FromLines() ragged lines; expected panic: %v, actual: %v\n", ErrRowLength, r)
+		}
+	}()
+	FromLines([]string{"12", "345"})
+}
@@ -0,0 +1,161 @@
+package matrix
+
+// Sparse is a generic sparse matrix representation backed by a map. Only the
+// elements which are not equal to the default value are stored which makes it
+// suitable for large matrices with very few meaningful elements.
+type Sparse[T comparable] struct {
+	rows int
+	cols int
+
+	// def is the value of all the elements which are not stored in data.
+	def  T
+	data map[[2]int]T
+
+	// bounds is the smallest rectangle containing all the stored elements,
+	// only valid if hasBounds is true. It is recomputed lazily if dirty is
+	// true, which is the case when an element on the boundary of the
+	// rectangle is reset to the default value.
+	bounds    Rect
+	hasBounds bool
+	dirty     bool
+}
+
+// Rect is a rectangular region of a matrix from the row MinRow to MaxRow and
+// from the column MinCol to MaxCol, all inclusive.
+type Rect struct {
+	MinRow, MaxRow int
+	MinCol, MaxCol int
+}
+
+// NewSparse creates a new Sparse matrix with r rows and c columns where every
+// element is initially def. NewSparse will panic if either r or c is zero or
+// negative.
+func NewSparse[T comparable](r, c int, def T) *Sparse[T] {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	return &Sparse[T]{
+		rows: r,
+		cols: c,
+		def:  def,
+		data: make(map[[2]int]T),
+	}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (s *Sparse[T]) Dims() (r, c int) {
+	return s.rows, s.cols
+}
+
+// At returns the value of a matrix element at row i, column j. It will panic
+// if i or j are out of bounds for the matrix.
+func (s *Sparse[T]) At(i, j int) T {
+	s.checkBounds(i, j)
+	if v, ok := s.data[[2]int{i, j}]; ok {
+		return v
+	}
+	return s.def
+}
+
+// Set sets the element at row i, column j to the value v. It will panic if i
+// or j are out of bounds for the matrix. Setting an element to the default
+// value removes it from the underlying storage.
+func (s *Sparse[T]) Set(i, j int, v T) {
+	s.checkBounds(i, j)
+	key := [2]int{i, j}
+	if v == s.def {
+		if _, ok := s.data[key]; ok {
+			delete(s.data, key)
+			if !s.dirty && s.onBoundary(i, j) {
+				s.dirty = true
+			}
+		}
+		return
+	}
+	s.data[key] = v
+	if !s.dirty {
+		s.extend(i, j)
+	}
+}
+
+// Default returns the value of the elements which are not stored.
+func (s *Sparse[T]) Default() T {
+	return s.def
+}
+
+// Len returns the number of elements stored in the matrix, that is, the number
+// of elements which are not equal to the default value.
+func (s *Sparse[T]) Len() int {
+	return len(s.data)
+}
+
+// Bounds returns the smallest rectangle which contains all the elements not
+// equal to the default value. The ok value is false if there are no such
+// elements.
+func (s *Sparse[T]) Bounds() (r Rect, ok bool) {
+	if s.dirty {
+		s.hasBounds = false
+		for key := range s.data {
+			s.extend(key[0], key[1])
+		}
+		s.dirty = false
+	}
+	return s.bounds, s.hasBounds
+}
+
+// ForEach is used to iterate over every stored element of the matrix, in an
+// arbitrary order, by calling a user-defined function with the row i, column
+// j and the value v of that element.
+func (s *Sparse[T]) ForEach(f func(i, j int, v T)) {
+	for key, v := range s.data {
+		f(key[0], key[1], v)
+	}
+}
+
+// ToDense returns a new Dense matrix with the same dimensions and elements as
+// the receiver.
+func (s *Sparse[T]) ToDense() *Dense[T] {
+	d := NewDense[T](s.rows, s.cols, nil)
+	d.Fill(s.def)
+	for key, v := range s.data {
+		d.Set(key[0], key[1], v)
+	}
+	return d
+}
+
+// T performs an implicit transpose by returning the receiver inside a Transpose.
+func (s *Sparse[T]) T() Matrix[T] {
+	return Transpose[T]{Matrix: s}
+}
+
+// extend extends the bounds to include the element at row i, column j.
+func (s *Sparse[T]) extend(i, j int) {
+	if !s.hasBounds {
+		s.bounds = Rect{MinRow: i, MaxRow: i, MinCol: j, MaxCol: j}
+		s.hasBounds = true
+		return
+	}
+	s.bounds.MinRow = min(s.bounds.MinRow, i)
+	s.bounds.MaxRow = max(s.bounds.MaxRow, i)
+	s.bounds.MinCol = min(s.bounds.MinCol, j)
+	s.bounds.MaxCol = max(s.bounds.MaxCol, j)
+}
+
+// onBoundary returns true if the element at row i, column j lies on the
+// boundary of the bounds.
+func (s *Sparse[T]) onBoundary(i, j int) bool {
+	b := s.bounds
+	return i == b.MinRow || i == b.MaxRow || j == b.MinCol || j == b.MaxCol
+}
+
+func (s *Sparse[T]) checkBounds(i, j int) {
+	if i >= s.rows || i < 0 {
+		panic(ErrRowAccess)
+	}
+	if j >= s.cols || j < 0 {
+		panic(ErrColAccess)
+	}
+}
@@ -0,0 +1,39 @@
+package matrix
+
+import "testing"
+
+func TestSparse(t *testing.T) {
+	var m Matrix[byte] = NewSparse[byte](1000, 1000, '.')
+	s := m.(*Sparse[byte])
+
+	if r, ok := s.Bounds(); ok {
+		t.Errorf("empty sparse matrix; expected no bounds, actual: %v\n", r)
+	}
+
+	s.Set(10, 20, '#')
+	s.Set(500, 5, '#')
+	s.Set(30, 900, '#')
+	if v := s.At(10, 20); v != '#' {
+		t.Errorf("s.At(10, 20); expected: '#', actual: %q\n", v)
+	}
+	if v := s.At(0, 0); v != '.' {
+		t.Errorf("s.At(0, 0); expected: '.', actual: %q\n", v)
+	}
+	if v := m.T().At(900, 30); v != '#' {
+		t.Errorf("s.T().At(900, 30); expected: '#', actual: %q\n", v)
+	}
+
+	expected := Rect{MinRow: 10, MaxRow: 500, MinCol: 5, MaxCol: 900}
+	if actual, _ := s.Bounds(); actual != expected {
+		t.Errorf("s.Bounds()\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	s.Set(500, 5, '.')
+	if s.Len() != 2 {
+		t.Errorf("s.Len(); expected: 2, actual: %d\n", s.Len())
+	}
+	expected = Rect{MinRow: 10, MaxRow: 30, MinCol: 20, MaxCol: 900}
+	if actual, _ := s.Bounds(); actual != expected {
+		t.Errorf("s.Bounds() after reset\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
@@ -0,0 +1,66 @@
+package matrix
+
+// Edge is a policy for computing the value of an element at row i, column j
+// which is out of bounds for the matrix m. It is used by Stencil to build the
+// window around the elements at the edges of the matrix.
+type Edge[T any] func(m *Dense[T], i, j int) T
+
+// ClampEdge returns an edge policy which uses the value of the nearest element
+// within the bounds of the matrix.
+func ClampEdge[T any]() Edge[T] {
+	return func(m *Dense[T], i, j int) T {
+		return m.At(max(0, min(i, m.Rows-1)), max(0, min(j, m.Cols-1)))
+	}
+}
+
+// WrapEdge returns an edge policy which wraps the index around the matrix as
+// if it were a torus.
+func WrapEdge[T any]() Edge[T] {
+	return func(m *Dense[T], i, j int) T {
+		return m.AtWrap(i, j)
+	}
+}
+
+// ConstantEdge returns an edge policy which uses the value v for all the
+// elements outside the bounds of the matrix.
+func ConstantEdge[T any](v T) Edge[T] {
+	return func(*Dense[T], int, int) T {
+		return v
+	}
+}
+
+// Stencil returns a new matrix with the same dimensions as the receiver where
+// every element is the value returned by fn for the window around the
+// respective element of the receiver. The window is a square matrix of size
+// 2*radius+1 centered at the element, so the element itself is at row and
+// column radius of the window. Elements of the window which are out of bounds
+// for the receiver are computed using the given edge policy.
+//
+// The window is reused between the calls to fn, so it must not be retained.
+// It will panic if radius is negative.
+func (m *Dense[T]) Stencil(radius int, edge Edge[T], fn func(window *Dense[T]) T) *Dense[T] {
+	if radius < 0 {
+		panic(ErrNegativeDimension)
+	}
+	size := 2*radius + 1
+	window := NewDense[T](size, size, nil)
+	result := NewDense[T](m.Rows, m.Cols, nil)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			for wi := 0; wi < size; wi++ {
+				for wj := 0; wj < size; wj++ {
+					r, c := i+wi-radius, j+wj-radius
+					var v T
+					if r < 0 || r >= m.Rows || c < 0 || c >= m.Cols {
+						v = edge(m, r, c)
+					} else {
+						v = m.Data[r*m.Stride+c]
+					}
+					window.Data[wi*size+wj] = v
+				}
+			}
+			result.Data[i*result.Stride+j] = fn(window)
+		}
+	}
+	return result
+}
@@ -0,0 +1,36 @@
+package matrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDenseStencil(t *testing.T) {
+	m := NewDense(2, 3, []int{1, 2, 3, 4, 5, 6})
+	sum := func(window *Dense[int]) int {
+		total := 0
+		for _, v := range window.Data {
+			total += v
+		}
+		return total
+	}
+
+	testCases := []struct {
+		name     string
+		edge     Edge[int]
+		expected []int
+	}{
+		{name: "constant", edge: ConstantEdge(0), expected: []int{12, 21, 16, 12, 21, 16}},
+		{name: "clamp", edge: ClampEdge[int](), expected: []int{21, 27, 33, 30, 36, 42}},
+		{name: "wrap", edge: WrapEdge[int](), expected: []int{36, 36, 36, 27, 27, 27}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := m.Stencil(1, tc.edge, sum)
+			if !reflect.DeepEqual(actual.Data, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual.Data)
+			}
+		})
+	}
+}
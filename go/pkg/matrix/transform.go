@@ -0,0 +1,146 @@
+package matrix
+
+// Rotate90 returns a new matrix which is the receiver rotated by 90 degrees
+// in the clockwise direction. The returned matrix has the number of rows and
+// columns swapped.
+func (m *Dense[T]) Rotate90() *Dense[T] {
+	n := NewDense[T](m.Cols, m.Rows, nil)
+	for i := 0; i < n.Rows; i++ {
+		for j := 0; j < n.Cols; j++ {
+			n.Data[i*n.Stride+j] = m.Data[(m.Rows-1-j)*m.Stride+i]
+		}
+	}
+	return n
+}
+
+// Rotate180 returns a new matrix which is the receiver rotated by 180 degrees.
+func (m *Dense[T]) Rotate180() *Dense[T] {
+	n := NewDense[T](m.Rows, m.Cols, nil)
+	for i := 0; i < n.Rows; i++ {
+		for j := 0; j < n.Cols; j++ {
+			n.Data[i*n.Stride+j] = m.Data[(m.Rows-1-i)*m.Stride+m.Cols-1-j]
+		}
+	}
+	return n
+}
+
+// Rotate270 returns a new matrix which is the receiver rotated by 270 degrees
+// in the clockwise direction, that is, 90 degrees in the counter clockwise
+// direction. The returned matrix has the number of rows and columns swapped.
+func (m *Dense[T]) Rotate270() *Dense[T] {
+	n := NewDense[T](m.Cols, m.Rows, nil)
+	for i := 0; i < n.Rows; i++ {
+		for j := 0; j < n.Cols; j++ {
+			n.Data[i*n.Stride+j] = m.Data[j*m.Stride+m.Cols-1-i]
+		}
+	}
+	return n
+}
+
+// FlipRows returns a new matrix with the order of the rows of the receiver
+// reversed, that is, the matrix is flipped upside down.
+func (m *Dense[T]) FlipRows() *Dense[T] {
+	n := NewDense[T](m.Rows, m.Cols, nil)
+	for i := 0; i < n.Rows; i++ {
+		copy(n.RawRowView(i), m.RawRowView(m.Rows-1-i))
+	}
+	return n
+}
+
+// FlipCols returns a new matrix with the order of the columns of the receiver
+// reversed, that is, the matrix is mirrored from left to right.
+func (m *Dense[T]) FlipCols() *Dense[T] {
+	n := m.Copy()
+	n.FlipColsInPlace()
+	return n
+}
+
+// Pad returns a new matrix with a border of n rows and columns on every side of
+// the receiver filled with the value fill. The returned matrix has 2*n more rows
+// and columns than the receiver. It will panic if n is negative.
+func (m *Dense[T]) Pad(n int, fill T) *Dense[T] {
+	if n < 0 {
+		panic(ErrNegativeDimension)
+	}
+	p := NewDense[T](m.Rows+2*n, m.Cols+2*n, nil)
+	p.Fill(fill)
+	p.Slice(n, n+m.Rows, n, n+m.Cols).CopyFrom(m)
+	return p
+}
+
+// Resize returns a new matrix with the given number of rows and columns
+// containing the elements of the receiver anchored at the top left corner.
+// Elements outside the receiver bounds are set to the value fill while the
+// elements of the receiver outside the new bounds are dropped.
+func (m *Dense[T]) Resize(rows, cols int, fill T) *Dense[T] {
+	r := NewDense[T](rows, cols, nil)
+	r.Fill(fill)
+	// Rows and columns which are common to both the matrices.
+	cr, cc := min(rows, m.Rows), min(cols, m.Cols)
+	r.Slice(0, cr, 0, cc).CopyFrom(m.Slice(0, cr, 0, cc))
+	return r
+}
+
+// Rotate90InPlace is similar to Rotate90 except that the receiver is modified.
+//
+// A square matrix is rotated within its backing data. For a non-square matrix
+// the dimensions change, so the receiver is updated to use a newly allocated
+// backing slice instead.
+func (m *Dense[T]) Rotate90InPlace() {
+	if m.Rows != m.Cols {
+		*m = *m.Rotate90()
+		return
+	}
+	m.transposeSquare()
+	m.FlipColsInPlace()
+}
+
+// Rotate180InPlace is similar to Rotate180 except that the receiver is
+// modified within its backing data.
+func (m *Dense[T]) Rotate180InPlace() {
+	m.FlipRowsInPlace()
+	m.FlipColsInPlace()
+}
+
+// Rotate270InPlace is similar to Rotate270 except that the receiver is
+// modified. The same rules as Rotate90InPlace applies for a non-square matrix.
+func (m *Dense[T]) Rotate270InPlace() {
+	if m.Rows != m.Cols {
+		*m = *m.Rotate270()
+		return
+	}
+	m.transposeSquare()
+	m.FlipRowsInPlace()
+}
+
+// FlipRowsInPlace is similar to FlipRows except that the receiver is modified
+// within its backing data.
+func (m *Dense[T]) FlipRowsInPlace() {
+	for i, k := 0, m.Rows-1; i < k; i, k = i+1, k-1 {
+		top, bottom := m.RawRowView(i), m.RawRowView(k)
+		for j := range top {
+			top[j], bottom[j] = bottom[j], top[j]
+		}
+	}
+}
+
+// FlipColsInPlace is similar to FlipCols except that the receiver is modified
+// within its backing data.
+func (m *Dense[T]) FlipColsInPlace() {
+	for i := 0; i < m.Rows; i++ {
+		row := m.RawRowView(i)
+		for j, k := 0, len(row)-1; j < k; j, k = j+1, k-1 {
+			row[j], row[k] = row[k], row[j]
+		}
+	}
+}
+
+// transposeSquare transposes the square receiver within its backing data.
+func (m *Dense[T]) transposeSquare() {
+	for i := 0; i < m.Rows; i++ {
+		for j := i + 1; j < m.Cols; j++ {
+			a, b := i*m.Stride+j, j*m.Stride+i
+			m.Data[a], m.Data[b] = m.Data[b], m.Data[a]
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package matrix
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDenseRotateAndFlip(t *testing.T) {
+	// 1 2 3
+	// 4 5 6
+	m := NewDense(2, 3, []int{1, 2, 3, 4, 5, 6})
+
+	testCases := []struct {
+		name     string
+		fn       func(*Dense[int]) *Dense[int]
+		inPlace  func(*Dense[int])
+		expected *Dense[int]
+	}{
+		{
+			name:     "rotate 90",
+			fn:       (*Dense[int]).Rotate90,
+			inPlace:  (*Dense[int]).Rotate90InPlace,
+			expected: NewDense(3, 2, []int{4, 1, 5, 2, 6, 3}),
+		},
+		{
+			name:     "rotate 180",
+			fn:       (*Dense[int]).Rotate180,
+			inPlace:  (*Dense[int]).Rotate180InPlace,
+			expected: NewDense(2, 3, []int{6, 5, 4, 3, 2, 1}),
+		},
+		{
+			name:     "rotate 270",
+			fn:       (*Dense[int]).Rotate270,
+			inPlace:  (*Dense[int]).Rotate270InPlace,
+			expected: NewDense(3, 2, []int{3, 6, 2, 5, 1, 4}),
+		},
+		{
+			name:     "flip rows",
+			fn:       (*Dense[int]).FlipRows,
+			inPlace:  (*Dense[int]).FlipRowsInPlace,
+			expected: NewDense(2, 3, []int{4, 5, 6, 1, 2, 3}),
+		},
+		{
+			name:     "flip cols",
+			fn:       (*Dense[int]).FlipCols,
+			inPlace:  (*Dense[int]).FlipColsInPlace,
+			expected: NewDense(2, 3, []int{3, 2, 1, 6, 5, 4}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.fn(m); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+			actual := NewDense(2, 3, []int{1, 2, 3, 4, 5, 6})
+			tc.inPlace(actual)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("in place\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDenseRotateInPlaceSquare(t *testing.T) {
+	m := NewDense(3, 3, []int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	data := m.Data
+
+	m.Rotate90InPlace()
+	if expected := []int{7, 4, 1, 8, 5, 2, 9, 6, 3}; !reflect.DeepEqual(data, expected) {
+		t.Errorf("rotate 90\nexpected: %v\nactual: %v\n", expected, data)
+	}
+
+	m.Rotate270InPlace()
+	if expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(data, expected) {
+		t.Errorf("rotate 270\nexpected: %v\nactual: %v\n", expected, data)
+	}
+}
+
+func TestDensePadAndResize(t *testing.T) {
+	m := NewDense(2, 2, []int{1, 2, 3, 4})
+
+	expected := NewDense(4, 4, []int{
+		0, 0, 0, 0,
+		0, 1, 2, 0,
+		0, 3, 4, 0,
+		0, 0, 0, 0,
+	})
+	if actual := m.Pad(1, 0); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("m.Pad(1, 0)\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	expected = NewDense(3, 1, []int{1, 3, 9})
+	if actual := m.Resize(3, 1, 9); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("m.Resize(3, 1, 9)\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
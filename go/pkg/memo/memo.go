@@ -0,0 +1,83 @@
+// Package memo implements the wrappers to cache the results of pure functions.
+//
+// The memoized functions are not safe for concurrent use.
+package memo
+
+// Func1 returns a memoized version of the function f which takes a single
+// argument.
+func Func1[A comparable, V any](f func(A) V) func(A) V {
+	cache := make(map[A]V)
+	return func(a A) V {
+		if v, ok := cache[a]; ok {
+			return v
+		}
+		v := f(a)
+		cache[a] = v
+		return v
+	}
+}
+
+// Func2 returns a memoized version of the function f which takes two
+// arguments.
+func Func2[A, B comparable, V any](f func(A, B) V) func(A, B) V {
+	type key struct {
+		a A
+		b B
+	}
+	cache := make(map[key]V)
+	return func(a A, b B) V {
+		k := key{a, b}
+		if v, ok := cache[k]; ok {
+			return v
+		}
+		v := f(a, b)
+		cache[k] = v
+		return v
+	}
+}
+
+// FuncKey returns a memoized version of the function f whose argument is not
+// comparable, like a slice. The key function is used to convert the argument
+// to a comparable cache key and it must return the same key for two arguments
+// only if f returns the same result for both of them.
+func FuncKey[A any, K comparable, V any](f func(A) V, key func(A) K) func(A) V {
+	cache := make(map[K]V)
+	return func(a A) V {
+		k := key(a)
+		if v, ok := cache[k]; ok {
+			return v
+		}
+		v := f(a)
+		cache[k] = v
+		return v
+	}
+}
+
+// Recursive1 is similar to Func1 but for a recursive function. The function f
+// receives the memoized version of itself as the first argument which should
+// be used for the recursive calls.
+//
+//	fib := memo.Recursive1(func(fib func(int) int, n int) int {
+//		if n < 2 {
+//			return n
+//		}
+//		return fib(n-1) + fib(n-2)
+//	})
+func Recursive1[A comparable, V any](f func(self func(A) V, a A) V) func(A) V {
+	var self func(A) V
+	self = Func1(func(a A) V {
+		return f(self, a)
+	})
+	return self
+}
+
+// Recursive2 is similar to Func2 but for a recursive function. The function f
+// receives the memoized version of itself as the first argument which should
+// be used for the recursive calls.
+func Recursive2[A, B comparable, V any](f func(self func(A, B) V, a A, b B) V) func(A, B) V {
+	var self func(A, B) V
+	self = Func2(func(a A, b B) V {
+		return f(self, a, b)
+	})
+	return self
+}
@@ -0,0 +1,86 @@
+package memo
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFunc1(t *testing.T) {
+	calls := 0
+	square := Func1(func(n int) int {
+		calls++
+		return n * n
+	})
+	for _, n := range []int{3, 4, 3, 3, 4} {
+		if actual := square(n); actual != n*n {
+			t.Errorf("square(%d); expected: %d, actual: %d\n", n, n*n, actual)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls to the function, got %d\n", calls)
+	}
+}
+
+func TestFunc2(t *testing.T) {
+	calls := 0
+	repeat := Func2(func(s string, n int) string {
+		calls++
+		return fmt.Sprintf("%s*%d", s, n)
+	})
+	repeat("a", 1)
+	repeat("a", 2)
+	repeat("a", 1)
+	if actual := repeat("b", 1); actual != "b*1" {
+		t.Errorf("repeat(b, 1); expected: b*1, actual: %s\n", actual)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls to the function, got %d\n", calls)
+	}
+}
+
+func TestFuncKey(t *testing.T) {
+	calls := 0
+	sum := FuncKey(func(ns []int) int {
+		calls++
+		total := 0
+		for _, n := range ns {
+			total += n
+		}
+		return total
+	}, func(ns []int) string { return fmt.Sprint(ns) })
+	sum([]int{1, 2, 3})
+	if actual := sum([]int{1, 2, 3}); actual != 6 {
+		t.Errorf("sum([1 2 3]); expected: 6, actual: %d\n", actual)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call to the function, got %d\n", calls)
+	}
+}
+
+func TestRecursive(t *testing.T) {
+	calls := 0
+	fib := Recursive1(func(fib func(int) int, n int) int {
+		calls++
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+	if actual := fib(90); actual != 2880067194370816120 {
+		t.Errorf("fib(90); expected: 2880067194370816120, actual: %d\n", actual)
+	}
+	if calls != 91 {
+		t.Errorf("expected 91 calls to the function, got %d\n", calls)
+	}
+
+	// Number of lattice paths from (0, 0) to (x, y).
+	paths := Recursive2(func(paths func(int, int) int, x, y int) int {
+		if x == 0 || y == 0 {
+			return 1
+		}
+		return paths(x-1, y) + paths(x, y-1)
+	})
+	if actual := paths(16, 16); actual != 601080390 {
+		t.Errorf("paths(16, 16); expected: 601080390, actual: %d\n", actual)
+	}
+}
@@ -147,3 +147,185 @@ var alphabet6 = map[string]string{
 		#...
 		####`): "Z",
 }
+
+var alphabet10 = map[string]string{
+	heredoc.Doc(`
+		..##..
+		.#..#.
+		#....#
+		#....#
+		#....#
+		######
+		#....#
+		#....#
+		#....#
+		#....#`): "A",
+
+	heredoc.Doc(`
+		#####.
+		#....#
+		#....#
+		#....#
+		#####.
+		#....#
+		#....#
+		#....#
+		#....#
+		#####.`): "B",
+
+	heredoc.Doc(`
+		.####.
+		#....#
+		#.....
+		#.....
+		#.....
+		#.....
+		#.....
+		#.....
+		#....#
+		.####.`): "C",
+
+	heredoc.Doc(`
+		######
+		#.....
+		#.....
+		#.....
+		#####.
+		#.....
+		#.....
+		#.....
+		#.....
+		######`): "E",
+
+	heredoc.Doc(`
+		######
+		#.....
+		#.....
+		#.....
+		#####.
+		#.....
+		#.....
+		#.....
+		#.....
+		#.....`): "F",
+
+	heredoc.Doc(`
+		.####.
+		#....#
+		#.....
+		#.....
+		#.....
+		#..###
+		#....#
+		#....#
+		#...##
+		.###.#`): "G",
+
+	heredoc.Doc(`
+		#....#
+		#....#
+		#....#
+		#....#
+		######
+		#....#
+		#....#
+		#....#
+		#....#
+		#....#`): "H",
+
+	heredoc.Doc(`
+		...###
+		....#.
+		....#.
+		....#.
+		....#.
+		....#.
+		....#.
+		#...#.
+		#...#.
+		.###..`): "J",
+
+	heredoc.Doc(`
+		#....#
+		#...#.
+		#..#..
+		#.#...
+		##....
+		##....
+		#.#...
+		#..#..
+		#...#.
+		#....#`): "K",
+
+	heredoc.Doc(`
+		#.....
+		#.....
+		#.....
+		#.....
+		#.....
+		#.....
+		#.....
+		#.....
+		#.....
+		######`): "L",
+
+	heredoc.Doc(`
+		#....#
+		##...#
+		##...#
+		#.#..#
+		#.#..#
+		#..#.#
+		#..#.#
+		#...##
+		#...##
+		#....#`): "N",
+
+	heredoc.Doc(`
+		#####.
+		#....#
+		#....#
+		#....#
+		#####.
+		#.....
+		#.....
+		#.....
+		#.....
+		#.....`): "P",
+
+	heredoc.Doc(`
+		#####.
+		#....#
+		#....#
+		#....#
+		#####.
+		#..#..
+		#...#.
+		#...#.
+		#....#
+		#....#`): "R",
+
+	heredoc.Doc(`
+		#....#
+		#....#
+		.#..#.
+		.#..#.
+		..##..
+		..##..
+		.#..#.
+		.#..#.
+		#....#
+		#....#`): "X",
+
+	heredoc.Doc(`
+		######
+		.....#
+		.....#
+		....#.
+		...#..
+		..#...
+		.#....
+		#.....
+		#.....
+		######`): "Z",
+}
@@ -6,9 +6,128 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/matrix"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/set"
+)
+
+var (
+	ErrRowLength   = errors.New("ocr: row length mismatch (expected 6)")
+	ErrRowLength10 = errors.New("ocr: row length mismatch (expected 10)")
+	ErrHeight      = errors.New("ocr: unsupported text height (expected 6 or 10)")
+)
+
+// font describes the dimensions of the letters of a specific height.
+type font struct {
+	// height and width are the number of rows and columns of a single letter.
+	height, width int
+	// stride is the number of columns between the start of two consecutive
+	// letters, including the empty columns between them.
+	stride int
+	// alphabet is a map from the text of a letter to the letter itself.
+	alphabet map[string]string
+	// errRowLength is the error returned when the number of lines does not
+	// match the height of the font.
+	errRowLength error
+}
+
+var (
+	font6  = &font{height: 6, width: 4, stride: 5, alphabet: alphabet6, errRowLength: ErrRowLength}
+	font10 = &font{height: 10, width: 6, stride: 8, alphabet: alphabet10, errRowLength: ErrRowLength10}
 )
 
-var ErrRowLength = errors.New("ocr: row length mismatch (expected 6)")
+// Convert will try to convert the given text to characters, detecting the
+// height of the letters from the number of lines. It supports the letters of
+// height 6 and 10 and returns ErrHeight for any other height.
+func Convert(text string) (string, error) {
+	return ConvertSlice(strings.Split(text, "\n"))
+}
+
+// ConvertSlice is similar to Convert except that the text is already
+// split into lines.
+func ConvertSlice(lines []string) (string, error) {
+	f, err := fontFor(len(lines))
+	if err != nil {
+		return "", err
+	}
+	return f.convert(lines)
+}
+
+// ConvertWith is similar to Convert except that the fill and empty pixels are
+// represented by the given on and off runes instead of '#' and '.'. It returns
+// an error if the text contains any other rune apart from the newlines.
+func ConvertWith(text string, on, off rune) (string, error) {
+	var b strings.Builder
+	for idx, r := range text {
+		switch r {
+		case on:
+			b.WriteByte('#')
+		case off:
+			b.WriteByte('.')
+		case '\n':
+			b.WriteByte('\n')
+		default:
+			return "", fmt.Errorf("ocr: offset %d: unexpected character %q", idx, r)
+		}
+	}
+	return Convert(b.String())
+}
+
+// ConvertFunc will try to convert the text of the given width and height to
+// characters where the pixel at column x and row y is filled if on returns
+// true for it. The height of the letters is detected as in Convert.
+//
+// If the width ends before the last letter is complete, the text is padded
+// with the empty columns on the right. The function on is only called for the
+// pixels within the given width and height.
+func ConvertFunc(width, height int, on func(x, y int) bool) (string, error) {
+	f, err := fontFor(height)
+	if err != nil {
+		return "", err
+	}
+	// Pad the last letter if it's incomplete, in which case the rightmost
+	// columns of it must be empty.
+	padded := width
+	if r := width % f.stride; r > 0 && r < f.width {
+		padded += f.width - r
+	}
+	lines := make([]string, height)
+	line := make([]byte, padded)
+	for y := 0; y < height; y++ {
+		for x := 0; x < padded; x++ {
+			if x < width && on(x, y) {
+				line[x] = '#'
+			} else {
+				line[x] = '.'
+			}
+		}
+		lines[y] = string(line)
+	}
+	return f.convert(lines)
+}
+
+// ConvertSet is similar to ConvertFunc where the filled pixels are the points
+// in the given set. The points are relative to the origin at the top-left
+// corner of the text, so the width and height are one more than the maximum
+// X and Y coordinates respectively.
+func ConvertSet(points set.Set[geom.Point2D[int]]) (string, error) {
+	var width, height int
+	for p := range points {
+		width, height = max(width, p.X+1), max(height, p.Y+1)
+	}
+	return ConvertFunc(width, height, func(x, y int) bool {
+		return points.Contains(geom.Point2D[int]{X: x, Y: y})
+	})
+}
+
+// ConvertMatrix is similar to ConvertFunc where the pixel at row i and column
+// j is filled if the respective element of the matrix is true.
+func ConvertMatrix(m *matrix.Dense[bool]) (string, error) {
+	return ConvertFunc(m.Cols, m.Rows, func(x, y int) bool {
+		return m.At(y, x)
+	})
+}
 
 // Convert6 will try to convert the given text of height 6 to characters.
 // The text should be separated using the newline character ('\n') which
@@ -25,8 +144,38 @@ func Convert6(text string) (string, error) {
 // The pixel characters are expected to be a hash character ('#') as the
 // fill pixel and a dot character ('.') as the empty pixel.
 func ConvertSlice6(lines []string) (string, error) {
-	if len(lines) != 6 {
-		return "", ErrRowLength
+	return font6.convert(lines)
+}
+
+// Convert10 will try to convert the given text of height 10 to characters.
+// The text should be separated using the newline character ('\n') which
+// will be used to split it. The expected length of lines is 10.
+func Convert10(text string) (string, error) {
+	return ConvertSlice10(strings.Split(text, "\n"))
+}
+
+// ConvertSlice10 is similar to ConvertSlice6 except that the letters are of
+// height 10 and width 6, separated by two empty columns.
+func ConvertSlice10(lines []string) (string, error) {
+	return font10.convert(lines)
+}
+
+// fontFor returns the font for the letters of the given height.
+func fontFor(height int) (*font, error) {
+	switch height {
+	case font6.height:
+		return font6, nil
+	case font10.height:
+		return font10, nil
+	default:
+		return nil, ErrHeight
+	}
+}
+
+// convert will try to convert the given lines to characters using the font.
+func (f *font) convert(lines []string) (string, error) {
+	if len(lines) != f.height {
+		return "", f.errRowLength
 	}
 	cols := len(lines[0])
 	for idx, line := range lines {
@@ -35,18 +184,21 @@ func ConvertSlice6(lines []string) (string, error) {
 		}
 	}
 
-	// Allocating space approximately. Each character is of 4 wide, but
-	// there will be space between them. So, this will allocate more space
-	// than the actual number of letters.
-	letters := make([]string, 0, cols/4)
+	// Allocating space approximately. There will be space between the
+	// letters, so this will allocate more space than the actual number of
+	// letters.
+	letters := make([]string, 0, cols/f.width)
 
-	charLines := make([]string, 6)
-	for i := 0; i < cols; i += 5 {
+	charLines := make([]string, f.height)
+	for i := 0; i < cols; i += f.stride {
+		if i+f.width > cols {
+			return "", fmt.Errorf("ocr: column %d: incomplete letter of width %d", i+1, cols-i)
+		}
 		for idx, line := range lines {
-			charLines[idx] = line[i : i+4]
+			charLines[idx] = line[i : i+f.width]
 		}
 		text := strings.Join(charLines, "\n")
-		letter, ok := alphabet6[text]
+		letter, ok := f.alphabet[text]
 		if !ok {
 			return "", fmt.Errorf("ocr: %q: unrecognized text", text)
 		}
@@ -0,0 +1,127 @@
+package ocr
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/matrix"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/set"
+)
+
+// hi is the text "HI" of height 6.
+var hi = heredoc.Doc(`
+	#..#..###
+	#..#...#.
+	####...#.
+	#..#...#.
+	#..#...#.
+	#..#..###`)
+
+func TestConvert(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "height 6",
+			text:     hi,
+			expected: "HI",
+		},
+		{
+			name: "height 10",
+			text: heredoc.Doc(`
+				#....#.....###
+				#....#......#.
+				#....#......#.
+				#....#......#.
+				######......#.
+				#....#......#.
+				#....#......#.
+				#....#..#...#.
+				#....#..#...#.
+				#....#...###..`),
+			expected: "HJ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := Convert(tc.text)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != tc.expected {
+				t.Errorf("\nexpected: %q\nactual: %q\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	if _, err := Convert("#\n#"); !errors.Is(err, ErrHeight) {
+		t.Errorf("Convert(); expected: %v, actual: %v\n", ErrHeight, err)
+	}
+	if _, err := Convert10("####\n#..."); !errors.Is(err, ErrRowLength10) {
+		t.Errorf("Convert10(); expected: %v, actual: %v\n", ErrRowLength10, err)
+	}
+	if _, err := Convert6("####\n#...\n###.\n#...\n#...\n#.."); err == nil {
+		t.Error("Convert6() ragged lines; expected an error")
+	}
+}
+
+func TestConvertTyped(t *testing.T) {
+	lines := strings.Split(hi, "\n")
+	on := func(x, y int) bool {
+		return x < len(lines[y]) && lines[y][x] == '#'
+	}
+
+	points := set.New[geom.Point2D[int]]()
+	m := matrix.NewDense[bool](len(lines), len(lines[0]), nil)
+	for y, line := range lines {
+		for x := range line {
+			if on(x, y) {
+				points.Add(geom.Point2D[int]{X: x, Y: y})
+				m.Set(y, x, true)
+			}
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		convert func() (string, error)
+	}{
+		{name: "with", convert: func() (string, error) {
+			return ConvertWith(strings.NewReplacer("#", "█", ".", " ").Replace(hi), '█', ' ')
+		}},
+		{name: "func", convert: func() (string, error) { return ConvertFunc(len(lines[0]), len(lines), on) }},
+		{name: "func trailing gap", convert: func() (string, error) { return ConvertFunc(len(lines[0])+1, len(lines), on) }},
+		{name: "set", convert: func() (string, error) { return ConvertSet(points) }},
+		{name: "matrix", convert: func() (string, error) { return ConvertMatrix(m) }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := tc.convert()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != "HI" {
+				t.Errorf("\nexpected: %q\nactual: %q\n", "HI", actual)
+			}
+		})
+	}
+
+	// The pixels in the gap after the last letter are ignored.
+	l, err := ConvertFunc(7, 10, func(x, y int) bool { return x == 0 || y == 9 })
+	if err != nil || l != "L" {
+		t.Errorf("ConvertFunc() trailing pixels; expected: L, actual: %q (%v)\n", l, err)
+	}
+	if _, err := ConvertWith(hi, 'x', '.'); err == nil {
+		t.Error("ConvertWith(); expected an error for the unexpected character")
+	}
+}
@@ -0,0 +1,172 @@
+package parse
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Binder parses a string using a regular expression and binds the named
+// capture groups onto the fields of a struct of type T.
+//
+// A capture group is bound to the exported field with the same name in the
+// `parse` struct tag, or the exported field whose name matches the group name
+// case-insensitively if no field has such tag:
+//
+//	type step struct {
+//		State string `parse:"state"`
+//		X1, X2 int
+//	}
+//
+//	b := parse.MustBinder[step](`^(?P<state>on|off) x=(?P<x1>-?\d+)\.\.(?P<x2>-?\d+)$`)
+//	s, err := b.Bind("on x=-5..10")
+//
+// The supported field types are strings, booleans, integers, floating-point
+// numbers and any type implementing encoding.TextUnmarshaler. The unnamed
+// capture groups are ignored.
+type Binder[T any] struct {
+	re *regexp.Regexp
+	// fields is a map from the index of a named capture group in re to the
+	// index of the respective field in T.
+	fields map[int]int
+}
+
+// NewBinder returns a new binder for the given regular expression. It returns
+// an error if the expression is invalid, T is not a struct, or a named capture
+// group does not correspond to a field of a supported type.
+func NewBinder[T any](pattern string) (*Binder[T], error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("parse: %s is not a struct", typ)
+	}
+	fields := make(map[int]int)
+	for i, name := range re.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		idx, ok := fieldFor(typ, name)
+		if !ok {
+			return nil, fmt.Errorf("parse: no field in %s for the group %q", typ, name)
+		}
+		if !isSupported(typ.Field(idx).Type) {
+			return nil, fmt.Errorf("parse: %s.%s: unsupported type %s", typ, typ.Field(idx).Name, typ.Field(idx).Type)
+		}
+		fields[i] = idx
+	}
+	return &Binder[T]{re: re, fields: fields}, nil
+}
+
+// MustBinder is like NewBinder but panics if the binder cannot be created.
+// It simplifies the safe initialization of global variables holding binders.
+func MustBinder[T any](pattern string) *Binder[T] {
+	b, err := NewBinder[T](pattern)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Bind returns a new value of type T with the fields set to the values of
+// the respective capture groups in s. The optional groups which did not
+// participate in the match are left with the zero value.
+//
+// It returns an error if s does not match the regular expression or a
+// captured value cannot be converted to the type of its field.
+func (b *Binder[T]) Bind(s string) (T, error) {
+	var v T
+	indices := b.re.FindStringSubmatchIndex(s)
+	if indices == nil {
+		return v, fmt.Errorf("parse: %q does not match %q", s, b.re)
+	}
+	rv := reflect.ValueOf(&v).Elem()
+	for group, idx := range b.fields {
+		start, end := indices[2*group], indices[2*group+1]
+		if start < 0 {
+			continue
+		}
+		if err := setField(rv.Field(idx), s[start:end]); err != nil {
+			return v, fmt.Errorf("parse: %s: %w", rv.Type().Field(idx).Name, err)
+		}
+	}
+	return v, nil
+}
+
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// fieldFor returns the index of the exported field in typ for the capture
+// group with the given name.
+func fieldFor(typ reflect.Type, name string) (int, bool) {
+	fallback := -1
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		if f.Tag.Get("parse") == name {
+			return i, true
+		}
+		if fallback < 0 && strings.EqualFold(f.Name, name) {
+			fallback = i
+		}
+	}
+	return fallback, fallback >= 0
+}
+
+// isSupported returns true if the value of the given type can be parsed from
+// a string.
+func isSupported(typ reflect.Type) bool {
+	if reflect.PointerTo(typ).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// setField parses s according to the type of the field and sets it.
+func setField(field reflect.Value, s string) error {
+	if u, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(s)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		field.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(s, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(s, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(v)
+	}
+	return nil
+}
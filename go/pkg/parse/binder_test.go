@@ -0,0 +1,94 @@
+package parse
+
+import (
+	"strings"
+	"testing"
+)
+
+type state bool
+
+func (s *state) UnmarshalText(text []byte) error {
+	*s = string(text) == "on"
+	return nil
+}
+
+type rebootStep struct {
+	State  state `parse:"state"`
+	X1, X2 int
+	Label  string `parse:"name"`
+	Weight uint8
+}
+
+func TestBinder(t *testing.T) {
+	b := MustBinder[rebootStep](`^(?P<state>on|off) x=(?P<x1>-?\d+)\.\.(?P<x2>-?\d+)(?: (?P<name>\w+))?(?: (?P<weight>\d+))?$`)
+
+	testCases := []struct {
+		name     string
+		s        string
+		expected rebootStep
+		err      string
+	}{
+		{
+			name:     "all",
+			s:        "on x=-5..10 first 7",
+			expected: rebootStep{State: true, X1: -5, X2: 10, Label: "first", Weight: 7},
+		},
+		{
+			name:     "optional",
+			s:        "off x=3..4",
+			expected: rebootStep{State: false, X1: 3, X2: 4},
+		},
+		{name: "no match", s: "toggle x=3..4", err: "does not match"},
+		{name: "out of range", s: "on x=1..2 a 300", err: "Weight"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := b.Bind(tc.s)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Errorf("expected error containing %q, got: %v\n", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != tc.expected {
+				t.Errorf("\nexpected: %+v\nactual: %+v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNewBinderErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  func() error
+	}{
+		{name: "not a struct", err: func() error {
+			_, err := NewBinder[int](`(?P<x>\d+)`)
+			return err
+		}},
+		{name: "missing field", err: func() error {
+			_, err := NewBinder[rebootStep](`(?P<y1>\d+)`)
+			return err
+		}},
+		{name: "unsupported type", err: func() error {
+			_, err := NewBinder[struct{ X []int }](`(?P<x>\d+)`)
+			return err
+		}},
+		{name: "invalid regex", err: func() error {
+			_, err := NewBinder[rebootStep](`(?P<x1>\d+`)
+			return err
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.err(); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
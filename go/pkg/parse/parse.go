@@ -0,0 +1,72 @@
+// Package parse implements the helpers to parse the puzzle inputs.
+package parse
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	intRegex  = regexp.MustCompile(`-?\d+`)
+	uintRegex = regexp.MustCompile(`\d+`)
+)
+
+// Ints returns all the signed integers in s in the order they appear,
+// ignoring any other characters. A minus sign immediately before the digits
+// makes the integer negative, so a range like "1-3" is parsed as 1 and -3.
+// Use UInts for such cases.
+func Ints(s string) []int {
+	return findInts(intRegex, s)
+}
+
+// UInts is similar to Ints except that every minus sign is ignored, so all
+// the integers are non-negative.
+func UInts(s string) []int {
+	return findInts(uintRegex, s)
+}
+
+// Fields2 splits s around sep and returns the two fields. If sep is empty, s
+// is split around the runs of white space instead. The boolean is false if s
+// does not contain exactly two fields.
+func Fields2(s, sep string) (a, b string, ok bool) {
+	fields := split(s, sep, 2)
+	if len(fields) != 2 {
+		return "", "", false
+	}
+	return fields[0], fields[1], true
+}
+
+// Fields3 is similar to Fields2 but for exactly three fields.
+func Fields3(s, sep string) (a, b, c string, ok bool) {
+	fields := split(s, sep, 3)
+	if len(fields) != 3 {
+		return "", "", "", false
+	}
+	return fields[0], fields[1], fields[2], true
+}
+
+// split splits s into n fields around sep, or around the runs of white space
+// if sep is empty. The result contains more than n fields if there are more
+// separators in s.
+func split(s, sep string, n int) []string {
+	if sep == "" {
+		return strings.Fields(s)
+	}
+	return strings.SplitN(s, sep, n+1)
+}
+
+func findInts(re *regexp.Regexp, s string) []int {
+	matches := re.FindAllString(s, -1)
+	ints := make([]int, len(matches))
+	for i, m := range matches {
+		n, err := strconv.Atoi(m)
+		if err != nil {
+			// The regex guarantees a valid integer, so this is only possible
+			// if the integer is out of range.
+			panic("parse: " + err.Error())
+		}
+		ints[i] = n
+	}
+	return ints
+}
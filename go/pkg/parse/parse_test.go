@@ -0,0 +1,61 @@
+package parse
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInts(t *testing.T) {
+	testCases := []struct {
+		name   string
+		s      string
+		ints   []int
+		uints  []int
+		fields []int
+	}{
+		{
+			name:  "sensor",
+			s:     "Sensor at x=2, y=-18: closest beacon is at x=-2, y=15",
+			ints:  []int{2, -18, -2, 15},
+			uints: []int{2, 18, 2, 15},
+		},
+		{
+			name:  "range",
+			s:     "1-3 a: abcde",
+			ints:  []int{1, -3},
+			uints: []int{1, 3},
+		},
+		{
+			name:  "none",
+			s:     "no numbers here",
+			ints:  []int{},
+			uints: []int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := Ints(tc.s); !reflect.DeepEqual(actual, tc.ints) {
+				t.Errorf("Ints()\nexpected: %v\nactual: %v\n", tc.ints, actual)
+			}
+			if actual := UInts(tc.s); !reflect.DeepEqual(actual, tc.uints) {
+				t.Errorf("UInts()\nexpected: %v\nactual: %v\n", tc.uints, actual)
+			}
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	if a, b, ok := Fields2("abc -> def", " -> "); !ok || a != "abc" || b != "def" {
+		t.Errorf("Fields2(); unexpected result: %q, %q, %t\n", a, b, ok)
+	}
+	if _, _, ok := Fields2("a,b,c", ","); ok {
+		t.Error("Fields2(); expected false for three fields")
+	}
+	if a, b, c, ok := Fields3("  move 1   up ", ""); !ok || a != "move" || b != "1" || c != "up" {
+		t.Errorf("Fields3(); unexpected result: %q, %q, %q, %t\n", a, b, c, ok)
+	}
+	if _, _, _, ok := Fields3("a b", ""); ok {
+		t.Error("Fields3(); expected false for two fields")
+	}
+}
@@ -0,0 +1,71 @@
+package queue
+
+import "fmt"
+
+// Bucket is a min priority queue for small non-negative integer priorities.
+// It keeps a separate bucket of items for every priority, so pushing is
+// constant time and popping is amortized constant time when the priorities
+// are pushed in a mostly increasing order, as is the case for Dijkstra's
+// algorithm on a graph with small edge weights.
+//
+// Unlike Priority, the items are not deduplicated and items with the same
+// priority are popped in the last in, first out order.
+//
+// The zero value is an empty queue ready to use.
+type Bucket[T any] struct {
+	buckets [][]T
+	cur     int // lowest priority which might have a non-empty bucket
+	n       int // number of items
+}
+
+// NewBucket returns an initialized empty bucket queue.
+func NewBucket[T any]() *Bucket[T] {
+	return new(Bucket[T])
+}
+
+// Push adds the item to the queue with the given priority. It panics if the
+// priority is negative.
+func (bq *Bucket[T]) Push(item T, priority int) {
+	if priority < 0 {
+		panic(fmt.Sprintf("queue: negative bucket priority %d", priority))
+	}
+	for priority >= len(bq.buckets) {
+		bq.buckets = append(bq.buckets, nil)
+	}
+	bq.buckets[priority] = append(bq.buckets[priority], item)
+	if bq.n == 0 || priority < bq.cur {
+		bq.cur = priority
+	}
+	bq.n++
+}
+
+// Pop removes and returns the item with the lowest priority along with its
+// priority.
+//
+// An attempt to pop when the queue is empty will return the zero value for
+// the item. Using multiple assignment, one can distinguish a missing entry
+// from a zero value. This is referred to as the "comma ok" idiom.
+func (bq *Bucket[T]) Pop() (item T, priority int, ok bool) {
+	if bq.n == 0 {
+		return item, 0, false
+	}
+	for len(bq.buckets[bq.cur]) == 0 {
+		bq.cur++
+	}
+	b := bq.buckets[bq.cur]
+	var zero T
+	item, b[len(b)-1] = b[len(b)-1], zero
+	bq.buckets[bq.cur] = b[:len(b)-1]
+	bq.n--
+	return item, bq.cur, true
+}
+
+// Len returns the number of items in the queue.
+func (bq *Bucket[T]) Len() int {
+	return bq.n
+}
+
+// IsEmpty is used to check whether the queue is empty or not.
+func (bq *Bucket[T]) IsEmpty() bool {
+	return bq.n == 0
+}
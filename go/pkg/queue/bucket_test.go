@@ -0,0 +1,38 @@
+package queue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBucket(t *testing.T) {
+	bq := NewBucket[string]()
+	if item, _, ok := bq.Pop(); ok {
+		t.Errorf("bq.Pop() empty queue; expected: nil, actual: %v\n", item)
+	}
+
+	bq.Push("a", 3)
+	bq.Push("b", 1)
+	bq.Push("c", 7)
+	if item, priority, _ := bq.Pop(); item != "b" || priority != 1 {
+		t.Errorf("bq.Pop(); expected: (b, 1), actual: (%s, %d)\n", item, priority)
+	}
+
+	// Push a lower priority than the ones already popped.
+	bq.Push("d", 0)
+	bq.Push("e", 3)
+
+	var items []string
+	var priorities []int
+	for !bq.IsEmpty() {
+		item, priority, _ := bq.Pop()
+		items = append(items, item)
+		priorities = append(priorities, priority)
+	}
+	if expected := []string{"d", "e", "a", "c"}; !reflect.DeepEqual(items, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, items)
+	}
+	if expected := []int{0, 3, 3, 7}; !reflect.DeepEqual(priorities, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, priorities)
+	}
+}
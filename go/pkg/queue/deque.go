@@ -0,0 +1,171 @@
+package queue
+
+import (
+	"fmt"
+	"iter"
+)
+
+// minDequeCap is the minimum capacity of the ring buffer once an element is
+// added to the deque.
+const minDequeCap = 16
+
+// Deque represents a double-ended queue which supports adding and removing
+// elements at both the ends in amortized constant time. It is backed by a
+// ring buffer which grows as needed.
+//
+// The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	buf  []T
+	head int // index of the front element in buf
+	n    int // number of elements
+}
+
+// NewDeque returns an initialized deque, optionally with the given elements.
+// The elements are added at the back in the same order as provided.
+func NewDeque[T any](es ...T) *Deque[T] {
+	d := new(Deque[T])
+	d.PushBack(es...)
+	return d
+}
+
+// PushBack adds all the given elements at the back of the deque in the same
+// order as provided.
+func (d *Deque[T]) PushBack(es ...T) {
+	for _, e := range es {
+		d.grow()
+		d.buf[d.index(d.n)] = e
+		d.n++
+	}
+}
+
+// PushFront adds all the given elements at the front of the deque. Multiple
+// elements are added one after the other, so the last element will be at
+// the front.
+func (d *Deque[T]) PushFront(es ...T) {
+	for _, e := range es {
+		d.grow()
+		d.head = d.index(len(d.buf) - 1)
+		d.buf[d.head] = e
+		d.n++
+	}
+}
+
+// PopFront removes the front element of the deque and returns it.
+//
+// An attempt to pop when the deque is empty will return the zero value for
+// the type of the elements in the deque. Using multiple assignment, one can
+// distinguish a missing entry from a zero value. This is referred to as the
+// "comma ok" idiom.
+func (d *Deque[T]) PopFront() (e T, ok bool) {
+	if d.n == 0 {
+		return e, false
+	}
+	var zero T
+	e, d.buf[d.head] = d.buf[d.head], zero
+	d.head = d.index(1)
+	d.n--
+	return e, true
+}
+
+// PopBack removes the back element of the deque and returns it, following
+// the same "comma ok" idiom as PopFront.
+func (d *Deque[T]) PopBack() (e T, ok bool) {
+	if d.n == 0 {
+		return e, false
+	}
+	var zero T
+	i := d.index(d.n - 1)
+	e, d.buf[i] = d.buf[i], zero
+	d.n--
+	return e, true
+}
+
+// Front returns the front element of the deque without removing it,
+// following the same "comma ok" idiom as PopFront.
+func (d *Deque[T]) Front() (e T, ok bool) {
+	if d.n == 0 {
+		return e, false
+	}
+	return d.buf[d.head], true
+}
+
+// Back returns the back element of the deque without removing it, following
+// the same "comma ok" idiom as PopFront.
+func (d *Deque[T]) Back() (e T, ok bool) {
+	if d.n == 0 {
+		return e, false
+	}
+	return d.buf[d.index(d.n-1)], true
+}
+
+// At returns the element at position i where 0 is the front of the deque. It
+// panics if i is out of range.
+func (d *Deque[T]) At(i int) T {
+	if i < 0 || i >= d.n {
+		panic(fmt.Sprintf("queue: deque index %d out of range [0:%d]", i, d.n))
+	}
+	return d.buf[d.index(i)]
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.n
+}
+
+// IsEmpty is used to check whether the deque is empty or not.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.n == 0
+}
+
+// Clear removes all the elements from the deque, retaining the allocated
+// capacity.
+func (d *Deque[T]) Clear() {
+	clear(d.buf)
+	d.head, d.n = 0, 0
+}
+
+// All returns an iterator over the elements of the deque from front to back,
+// without removing them. The deque should not be modified during the
+// iteration.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < d.n; i++ {
+			if !yield(d.buf[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns a slice containing the elements of the deque from front to
+// back. Mutating the returned slice will not affect the deque.
+func (d *Deque[T]) ToSlice() []T {
+	sl := make([]T, d.n)
+	if d.n > 0 {
+		m := copy(sl, d.buf[d.head:min(d.head+d.n, len(d.buf))])
+		copy(sl[m:], d.buf[:d.n-m])
+	}
+	return sl
+}
+
+func (d *Deque[T]) String() string {
+	return fmt.Sprintf("Deque%v", d.ToSlice())
+}
+
+// index returns the index in the ring buffer for the position i relative to
+// the front of the deque.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+// grow doubles the capacity of the ring buffer if it's full, moving the
+// elements so that the front is at index 0.
+func (d *Deque[T]) grow() {
+	if d.n < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(2*len(d.buf), minDequeCap))
+	m := copy(buf, d.buf[d.head:])
+	copy(buf[m:], d.buf[:d.head])
+	d.buf, d.head = buf, 0
+}
@@ -0,0 +1,70 @@
+package queue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+	if e, ok := d.PopFront(); ok {
+		t.Errorf("d.PopFront() empty deque; expected: nil, actual: %v\n", e)
+	}
+	if e, ok := d.PopBack(); ok {
+		t.Errorf("d.PopBack() empty deque; expected: nil, actual: %v\n", e)
+	}
+
+	d.PushBack(3, 4, 5)
+	d.PushFront(2, 1)
+	if expected, actual := []int{1, 2, 3, 4, 5}, d.ToSlice(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if e, _ := d.Front(); e != 1 {
+		t.Errorf("d.Front(); expected: 1, actual: %d\n", e)
+	}
+	if e, _ := d.Back(); e != 5 {
+		t.Errorf("d.Back(); expected: 5, actual: %d\n", e)
+	}
+	if e := d.At(3); e != 4 {
+		t.Errorf("d.At(3); expected: 4, actual: %d\n", e)
+	}
+
+	if e, _ := d.PopFront(); e != 1 {
+		t.Errorf("d.PopFront(); expected: 1, actual: %d\n", e)
+	}
+	if e, _ := d.PopBack(); e != 5 {
+		t.Errorf("d.PopBack(); expected: 5, actual: %d\n", e)
+	}
+	if length := d.Len(); length != 3 {
+		t.Errorf("d.Len(); expected: 3, actual: %d\n", length)
+	}
+}
+
+func TestDequeGrowWrapped(t *testing.T) {
+	d := NewDeque[int]()
+	// Wrap the front around the end of the ring buffer before growing it.
+	for i := 0; i < 10; i++ {
+		d.PushFront(-i)
+		d.PushBack(i)
+	}
+
+	var expected []int
+	for i := -9; i <= 9; i++ {
+		expected = append(expected, i)
+		if i == 0 {
+			expected = append(expected, 0)
+		}
+	}
+	if actual := d.ToSlice(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	for _, e := range expected {
+		if actual, ok := d.PopFront(); !ok || actual != e {
+			t.Fatalf("d.PopFront(); expected: %d, actual: %d\n", e, actual)
+		}
+	}
+	if !d.IsEmpty() {
+		t.Errorf("d.IsEmpty(); expected empty deque, actual: %v\n", d)
+	}
+}
@@ -0,0 +1,106 @@
+package queue
+
+import "github.com/dhruvmanila/advent-of-code/go/pkg/heap"
+
+// Priority is a min priority queue of unique items where the item with the
+// lowest priority is popped first. Unlike PriorityQueue, the items are typed
+// and the priority of an item already in the queue can be updated, which is
+// what the decrease key operation of Dijkstra's algorithm requires.
+type Priority[T comparable] struct {
+	h     *heap.Heap[*priorityEntry[T]]
+	index map[T]*priorityEntry[T]
+}
+
+// NewPriority returns an initialized empty priority queue.
+func NewPriority[T comparable]() *Priority[T] {
+	return &Priority[T]{
+		h: heap.NewIndexed(
+			func(a, b *priorityEntry[T]) bool { return a.priority < b.priority },
+			func(e *priorityEntry[T], i int) { e.index = i },
+		),
+		index: make(map[T]*priorityEntry[T]),
+	}
+}
+
+// Push adds the item to the queue with the given priority. If the item is
+// already in the queue, its priority is updated instead.
+func (pq *Priority[T]) Push(item T, priority int) {
+	if pq.Update(item, priority) {
+		return
+	}
+	e := &priorityEntry[T]{item: item, priority: priority}
+	pq.index[item] = e
+	pq.h.Push(e)
+}
+
+// Pop removes and returns the item with the lowest priority along with its
+// priority. Items with the same priority are popped in an unspecified order.
+//
+// An attempt to pop when the queue is empty will return the zero value for
+// the item. Using multiple assignment, one can distinguish a missing entry
+// from a zero value. This is referred to as the "comma ok" idiom.
+func (pq *Priority[T]) Pop() (item T, priority int, ok bool) {
+	e, ok := pq.h.Pop()
+	if !ok {
+		return item, 0, false
+	}
+	delete(pq.index, e.item)
+	return e.item, e.priority, true
+}
+
+// Peek returns the item with the lowest priority along with its priority
+// without removing it from the queue, following the same "comma ok" idiom as
+// Pop.
+func (pq *Priority[T]) Peek() (item T, priority int, ok bool) {
+	e, ok := pq.h.Peek()
+	if !ok {
+		return item, 0, false
+	}
+	return e.item, e.priority, true
+}
+
+// Update changes the priority of the item which is already in the queue. It
+// returns false if the item is not in the queue in which case nothing is done.
+func (pq *Priority[T]) Update(item T, priority int) bool {
+	e, ok := pq.index[item]
+	if !ok {
+		return false
+	}
+	e.priority = priority
+	pq.h.Fix(e.index)
+	return true
+}
+
+// Get returns the priority of the item in the queue, and false if the item is
+// not in the queue.
+func (pq *Priority[T]) Get(item T) (priority int, ok bool) {
+	e, ok := pq.index[item]
+	if !ok {
+		return 0, false
+	}
+	return e.priority, true
+}
+
+// Contains returns true if the item is in the queue.
+func (pq *Priority[T]) Contains(item T) bool {
+	_, ok := pq.index[item]
+	return ok
+}
+
+// Len returns the number of items in the queue.
+func (pq *Priority[T]) Len() int {
+	return pq.h.Len()
+}
+
+// IsEmpty is used to check whether the queue is empty or not.
+func (pq *Priority[T]) IsEmpty() bool {
+	return pq.Len() == 0
+}
+
+// priorityEntry is an item in the priority queue along with its priority and
+// the current index in the heap.
+type priorityEntry[T any] struct {
+	item     T
+	priority int
+	index    int
+}
@@ -0,0 +1,54 @@
+package queue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPriority(t *testing.T) {
+	pq := NewPriority[string]()
+	if item, _, ok := pq.Pop(); ok {
+		t.Errorf("pq.Pop() empty queue; expected: nil, actual: %v\n", item)
+	}
+
+	pq.Push("a", 5)
+	pq.Push("b", 3)
+	pq.Push("c", 8)
+	pq.Push("d", 1)
+	if length := pq.Len(); length != 4 {
+		t.Errorf("pq.Len(); expected: 4, actual: %d\n", length)
+	}
+
+	if !pq.Update("c", 2) {
+		t.Error("pq.Update(\"c\", 2); expected the item to be in the queue")
+	}
+	if pq.Update("z", 0) {
+		t.Error("pq.Update(\"z\", 0); expected the item to not be in the queue")
+	}
+	// Pushing an existing item updates its priority.
+	pq.Push("d", 4)
+	if priority, ok := pq.Get("d"); !ok || priority != 4 {
+		t.Errorf("pq.Get(\"d\"); expected: 4, actual: %d\n", priority)
+	}
+
+	if item, priority, _ := pq.Peek(); item != "c" || priority != 2 {
+		t.Errorf("pq.Peek(); expected: (c, 2), actual: (%s, %d)\n", item, priority)
+	}
+
+	var items []string
+	var priorities []int
+	for !pq.IsEmpty() {
+		item, priority, _ := pq.Pop()
+		items = append(items, item)
+		priorities = append(priorities, priority)
+	}
+	if expected := []string{"c", "b", "d", "a"}; !reflect.DeepEqual(items, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, items)
+	}
+	if expected := []int{2, 3, 4, 5}; !reflect.DeepEqual(priorities, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, priorities)
+	}
+	if pq.Contains("a") {
+		t.Error("pq.Contains(\"a\"); expected popped item to be removed")
+	}
+}
@@ -1,10 +1,19 @@
 package queue
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+)
 
-// Queue represents a simple queue data structure. It is backed by a slice of
-// an unconstrained type T.
-type Queue[T any] []T
+// Queue represents a simple queue data structure. It is backed by a ring
+// buffer of an unconstrained type T, so the memory used by the queue is
+// proportional to the maximum number of elements in it at any point instead
+// of the total number of elements ever enqueued.
+//
+// The zero value is an empty queue ready to use.
+type Queue[T any] struct {
+	d Deque[T]
+}
 
 // New returns an initialized queue, optionally with the given elements. The
 // elements are added in the same order as provided.
@@ -19,7 +28,22 @@ func New[T any](es ...T) *Queue[T] {
 // Enqueue is used to enqueue all the given elements to the queue. Multiple
 // elements are added in the same order as provided.
 func (q *Queue[T]) Enqueue(es ...T) {
-	*q = append(*q, es...)
+	q.d.PushBack(es...)
+}
+
+// EnqueueSlice is used to enqueue all the elements of the given slice to the
+// queue in the same order. The slice is copied, so it can be reused by the
+// caller.
+func (q *Queue[T]) EnqueueSlice(sl []T) {
+	q.d.PushBack(sl...)
+}
+
+// EnqueueSeq is used to enqueue all the elements yielded by the given
+// sequence to the queue in the same order.
+func (q *Queue[T]) EnqueueSeq(seq iter.Seq[T]) {
+	for e := range seq {
+		q.d.PushBack(e)
+	}
 }
 
 // Dequeue is used to dequeue or remove the frontmost element from the queue
@@ -30,17 +54,7 @@ func (q *Queue[T]) Enqueue(es ...T) {
 // distinguish a missing entry from a zero value. This is referred to as the
 // "comma ok" idiom.
 func (q *Queue[T]) Dequeue() (e T, ok bool) {
-	sl := *q
-	if len(sl) == 0 {
-		return e, false
-	}
-	e = sl[0]
-	if len(sl) == 1 {
-		*q = nil // Clear the slice
-	} else {
-		*q = sl[1:]
-	}
-	return e, true
+	return q.d.PopFront()
 }
 
 // Peek returns the frontmost element of the queue without removing it.
@@ -50,16 +64,12 @@ func (q *Queue[T]) Dequeue() (e T, ok bool) {
 // distinguish a missing entry from a zero value. This is referred to as the
 // "comma ok" idiom.
 func (q *Queue[T]) Peek() (e T, ok bool) {
-	sl := *q
-	if len(sl) == 0 {
-		return e, false
-	}
-	return sl[0], true
+	return q.d.Front()
 }
 
 // Len returns the number of elements in the queue.
 func (q *Queue[T]) Len() int {
-	return len(*q)
+	return q.d.Len()
 }
 
 // IsEmpty is used to check whether the queue is empty or not.
@@ -67,15 +77,35 @@ func (q *Queue[T]) IsEmpty() bool {
 	return q.Len() == 0
 }
 
+// All returns an iterator over the elements of the queue from the front to
+// the back, without removing them. The queue should not be modified during
+// the iteration.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return q.d.All()
+}
+
+// Clone returns a new queue containing the first n elements of the queue,
+// i.e., the ones which would be dequeued first, in the same order. If n is
+// negative or is greater than the length of the queue, all the elements are
+// copied. Only the first n elements are visited.
+func (q *Queue[T]) Clone(n int) *Queue[T] {
+	if n < 0 || n > q.Len() {
+		n = q.Len()
+	}
+	c := new(Queue[T])
+	for i := 0; i < n; i++ {
+		c.d.PushBack(q.d.At(i))
+	}
+	return c
+}
+
 // ToSlice returns a slice containing the elements of the queue where the first
 // element is the start of the queue. Mutating the returned slice will not
 // affect the underlying implementation.
 func (q *Queue[T]) ToSlice() []T {
-	sl := make([]T, q.Len())
-	copy(sl, *q)
-	return sl
+	return q.d.ToSlice()
 }
 
 func (q *Queue[T]) String() string {
-	return fmt.Sprintf("Queue%v", *q)
+	return fmt.Sprintf("Queue%v", q.ToSlice())
 }
@@ -0,0 +1,93 @@
+package queue
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestQueue(t *testing.T) {
+	q := New(1, 2)
+	if e, ok := q.Peek(); !ok || e != 1 {
+		t.Errorf("q.Peek(); expected: 1, actual: %d\n", e)
+	}
+
+	// Interleave the operations so that the ring buffer wraps around.
+	var dequeued []int
+	for i := 3; i <= 40; i++ {
+		q.Enqueue(i)
+		if i%3 == 0 {
+			e, _ := q.Dequeue()
+			dequeued = append(dequeued, e)
+		}
+	}
+	for !q.IsEmpty() {
+		e, _ := q.Dequeue()
+		dequeued = append(dequeued, e)
+	}
+
+	expected := make([]int, 40)
+	for i := range expected {
+		expected[i] = i + 1
+	}
+	if !reflect.DeepEqual(dequeued, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, dequeued)
+	}
+	if e, ok := q.Dequeue(); ok {
+		t.Errorf("q.Dequeue() empty queue; expected: nil, actual: %v\n", e)
+	}
+}
+
+func TestQueueString(t *testing.T) {
+	q := New("a", "b")
+	if s := q.String(); s != "Queue[a b]" {
+		t.Errorf("q.String(); expected: Queue[a b], actual: %s\n", s)
+	}
+}
+
+func TestQueueBulk(t *testing.T) {
+	q := New[int]()
+	sl := []int{1, 2}
+	q.EnqueueSlice(sl)
+	sl[0] = 10
+	q.EnqueueSeq(slices.Values([]int{3, 4}))
+
+	if actual, expected := slices.Collect(q.All()), []int{1, 2, 3, 4}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	// Iterating should not remove the elements.
+	if length := q.Len(); length != 4 {
+		t.Errorf("q.Len() after All; expected: 4, actual: %d\n", length)
+	}
+}
+
+func TestQueueClone(t *testing.T) {
+	q := New(1, 2, 3, 4)
+	q.Dequeue()
+	q.Enqueue(5)
+
+	testCases := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{name: "prefix", n: 2, expected: []int{2, 3}},
+		{name: "none", n: 0, expected: []int{}},
+		{name: "all", n: -1, expected: []int{2, 3, 4, 5}},
+		{name: "overflow", n: 10, expected: []int{2, 3, 4, 5}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := q.Clone(tc.n)
+			if actual := c.ToSlice(); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+			// Modifying the clone should not affect the original queue.
+			c.Enqueue(10)
+			if length := q.Len(); length != 4 {
+				t.Errorf("q.Len() after cloning; expected: 4, actual: %d\n", length)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package queue
+
+import (
+	"fmt"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/set"
+)
+
+// UniqueQueue is a queue which remembers every element ever enqueued and
+// silently drops the elements which were already enqueued before, even if
+// they have been dequeued since then. This is the frontier of a breadth first
+// search combined with the set of visited nodes.
+type UniqueQueue[T comparable] struct {
+	q    Queue[T]
+	seen set.Set[T]
+}
+
+// NewUnique returns an initialized unique queue, optionally with the given
+// elements. The elements are added in the same order as provided, skipping
+// the duplicates.
+func NewUnique[T comparable](es ...T) *UniqueQueue[T] {
+	q := &UniqueQueue[T]{seen: set.New[T]()}
+	q.Enqueue(es...)
+	return q
+}
+
+// Enqueue is used to enqueue all the given elements to the queue which were
+// never enqueued before. Multiple elements are added in the same order as
+// provided.
+func (q *UniqueQueue[T]) Enqueue(es ...T) {
+	for _, e := range es {
+		if q.seen.Contains(e) {
+			continue
+		}
+		q.seen.Add(e)
+		q.q.Enqueue(e)
+	}
+}
+
+// Dequeue is used to dequeue or remove the frontmost element from the queue
+// and return it. The element is still remembered as seen.
+//
+// An attempt to dequeue when the queue is empty will return the zero value for
+// the type of the elements in the queue. Using multiple assignment, one can
+// distinguish a missing entry from a zero value. This is referred to as the
+// "comma ok" idiom.
+func (q *UniqueQueue[T]) Dequeue() (e T, ok bool) {
+	return q.q.Dequeue()
+}
+
+// Peek returns the frontmost element of the queue without removing it,
+// following the same "comma ok" idiom as Dequeue.
+func (q *UniqueQueue[T]) Peek() (e T, ok bool) {
+	return q.q.Peek()
+}
+
+// Seen returns true if the element was ever enqueued.
+func (q *UniqueQueue[T]) Seen(e T) bool {
+	return q.seen.Contains(e)
+}
+
+// SeenLen returns the number of unique elements ever enqueued.
+func (q *UniqueQueue[T]) SeenLen() int {
+	return q.seen.Len()
+}
+
+// Len returns the number of elements currently in the queue.
+func (q *UniqueQueue[T]) Len() int {
+	return q.q.Len()
+}
+
+// IsEmpty is used to check whether the queue is empty or not.
+func (q *UniqueQueue[T]) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+// ToSlice returns a slice containing the elements currently in the queue
+// where the first element is the start of the queue.
+func (q *UniqueQueue[T]) ToSlice() []T {
+	return q.q.ToSlice()
+}
+
+func (q *UniqueQueue[T]) String() string {
+	return fmt.Sprintf("UniqueQueue%v", q.ToSlice())
+}
@@ -0,0 +1,26 @@
+package queue
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUniqueQueue(t *testing.T) {
+	q := NewUnique(1, 2, 1)
+	if expected, actual := []int{1, 2}, q.ToSlice(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	e, _ := q.Dequeue()
+	if !q.Seen(e) {
+		t.Errorf("q.Seen(%d); expected dequeued element to be seen\n", e)
+	}
+	// Elements which were dequeued are still dropped.
+	q.Enqueue(3, 1, 2, 4, 3)
+	if expected, actual := []int{2, 3, 4}, q.ToSlice(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if length := q.SeenLen(); length != 4 {
+		t.Errorf("q.SeenLen(); expected: 4, actual: %d\n", length)
+	}
+}
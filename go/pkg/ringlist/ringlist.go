@@ -0,0 +1,275 @@
+// Package ringlist implements a circular doubly linked list.
+//
+// Unlike container/ring, the list keeps track of its length and the handles
+// of the elements in their insertion order. This makes it possible to insert,
+// remove and move an element in constant time given its handle, which is
+// what puzzles like mixing a list of numbers or the crab cups game need.
+package ringlist
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// Element is an element of the circular list.
+type Element[T any] struct {
+	// Value is the value stored with this element.
+	Value T
+
+	next, prev *Element[T]
+	// list is the list to which this element belongs, nil if it has been
+	// removed.
+	list *List[T]
+}
+
+// Next returns the next element in the list, wrapping around at the end. It
+// returns nil if the element has been removed from the list.
+func (e *Element[T]) Next() *Element[T] {
+	if e.list == nil {
+		return nil
+	}
+	return e.next
+}
+
+// Prev returns the previous element in the list, wrapping around at the
+// start. It returns nil if the element has been removed from the list.
+func (e *Element[T]) Prev() *Element[T] {
+	if e.list == nil {
+		return nil
+	}
+	return e.prev
+}
+
+// Move returns the element n positions after e, or before e if n is
+// negative, without modifying the list. It walks the shorter way around the
+// list. It returns nil if the element has been removed from the list.
+func (e *Element[T]) Move(n int) *Element[T] {
+	if e.list == nil {
+		return nil
+	}
+	return e.move(shortest(n, e.list.len))
+}
+
+// move walks n positions after e, or before e if n is negative.
+func (e *Element[T]) move(n int) *Element[T] {
+	for ; n > 0; n-- {
+		e = e.next
+	}
+	for ; n < 0; n++ {
+		e = e.prev
+	}
+	return e
+}
+
+// List is a circular doubly linked list. The zero value is an empty list
+// ready to use.
+type List[T any] struct {
+	// root is an arbitrary element of the list used as the start and end of
+	// the list for the operations which need it, nil if the list is empty.
+	root *Element[T]
+	len  int
+	// handles are all the elements inserted into the list in the insertion
+	// order.
+	handles []*Element[T]
+}
+
+// New returns a new list containing the given values in order.
+func New[T any](values ...T) *List[T] {
+	l := &List[T]{handles: make([]*Element[T], 0, len(values))}
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return l
+}
+
+// Len returns the number of elements in the list.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Front returns the first element of the list, which is the element after
+// the last one, or nil if the list is empty.
+func (l *List[T]) Front() *Element[T] {
+	return l.root
+}
+
+// Back returns the last element of the list, which is the element before the
+// first one, or nil if the list is empty.
+func (l *List[T]) Back() *Element[T] {
+	if l.root == nil {
+		return nil
+	}
+	return l.root.prev
+}
+
+// Handle returns the element which was the i-th one inserted into the list.
+// The element may have been removed from the list since. It panics if i is
+// out of range.
+func (l *List[T]) Handle(i int) *Element[T] {
+	return l.handles[i]
+}
+
+// PushBack inserts a new element with value v at the back of the list and
+// returns it.
+func (l *List[T]) PushBack(v T) *Element[T] {
+	if l.root == nil {
+		e := l.newElement(v)
+		e.next, e.prev = e, e
+		l.root = e
+		l.len++
+		return e
+	}
+	return l.insertAfter(l.newElement(v), l.root.prev)
+}
+
+// InsertAfter inserts a new element with value v immediately after mark and
+// returns it. It panics if mark is not an element of l.
+func (l *List[T]) InsertAfter(v T, mark *Element[T]) *Element[T] {
+	l.checkElement(mark)
+	return l.insertAfter(l.newElement(v), mark)
+}
+
+// InsertBefore inserts a new element with value v immediately before mark
+// and returns it. It panics if mark is not an element of l.
+func (l *List[T]) InsertBefore(v T, mark *Element[T]) *Element[T] {
+	l.checkElement(mark)
+	return l.insertAfter(l.newElement(v), mark.prev)
+}
+
+// Remove removes the element e from the list and returns its value. It panics
+// if e is not an element of l.
+func (l *List[T]) Remove(e *Element[T]) T {
+	l.checkElement(e)
+	l.unlink(e)
+	e.list = nil
+	return e.Value
+}
+
+// MoveAfter moves the element e to its new position after mark. If e and mark
+// are the same element, the list is not modified. It panics if either of
+// them is not an element of l.
+func (l *List[T]) MoveAfter(e, mark *Element[T]) {
+	l.checkElement(e)
+	l.checkElement(mark)
+	if e == mark {
+		return
+	}
+	l.unlink(e)
+	l.insertAfter(e, mark)
+}
+
+// MoveN moves the element e n positions forward in the list, or backward if
+// n is negative. The movement is relative to the other elements, so moving
+// an element by Len()-1 positions in either direction brings it back to the
+// same position. It panics if e is not an element of l.
+func (l *List[T]) MoveN(e *Element[T], n int) {
+	l.checkElement(e)
+	if l.len < 2 {
+		return
+	}
+	n = shortest(n, l.len-1)
+	if n == 0 {
+		return
+	}
+	mark := e.prev
+	l.unlink(e)
+	l.insertAfter(e, mark.move(n))
+}
+
+// All returns an iterator over the values in the list, once around the list,
+// starting at the element from. It panics if from is not an element of l.
+func (l *List[T]) All(from *Element[T]) iter.Seq[T] {
+	l.checkElement(from)
+	return func(yield func(T) bool) {
+		e := from
+		for i := 0; i < l.len; i++ {
+			if !yield(e.Value) {
+				return
+			}
+			e = e.next
+		}
+	}
+}
+
+// Values returns the values in the list, once around the list, starting at
+// the element from. It panics if from is not an element of l.
+func (l *List[T]) Values(from *Element[T]) []T {
+	values := make([]T, 0, l.len)
+	for v := range l.All(from) {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (l *List[T]) String() string {
+	if l.root == nil {
+		return "RingList[]"
+	}
+	var sb strings.Builder
+	sb.WriteString("RingList[")
+	for v := range l.All(l.root) {
+		if sb.Len() > len("RingList[") {
+			sb.WriteByte(' ')
+		}
+		fmt.Fprint(&sb, v)
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// newElement returns a new element with value v belonging to l, recording
+// its handle.
+func (l *List[T]) newElement(v T) *Element[T] {
+	e := &Element[T]{Value: v, list: l}
+	l.handles = append(l.handles, e)
+	return e
+}
+
+// insertAfter links e after mark and returns e.
+func (l *List[T]) insertAfter(e, mark *Element[T]) *Element[T] {
+	e.prev = mark
+	e.next = mark.next
+	mark.next.prev = e
+	mark.next = e
+	l.len++
+	return e
+}
+
+// unlink removes e from the neighboring elements without detaching it from
+// the list.
+func (l *List[T]) unlink(e *Element[T]) {
+	if l.root == e {
+		if e.next == e {
+			l.root = nil
+		} else {
+			l.root = e.next
+		}
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next, e.prev = nil, nil
+	l.len--
+}
+
+func (l *List[T]) checkElement(e *Element[T]) {
+	if e.list != l {
+		panic("ringlist: element is not in the list")
+	}
+}
+
+// shortest reduces n modulo length to the equivalent number of steps with
+// the smallest magnitude, walking the other way around if it's shorter.
+func shortest(n, length int) int {
+	if length == 0 {
+		return 0
+	}
+	n %= length
+	switch {
+	case n > length/2:
+		n -= length
+	case n < -length/2:
+		n += length
+	}
+	return n
+}
@@ -0,0 +1,91 @@
+package ringlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListMoveN(t *testing.T) {
+	// 2022/20 sample where every number is moved by its own value.
+	numbers := []int{1, 2, -3, 3, -2, 0, 4}
+	l := New(numbers...)
+	for i := range numbers {
+		e := l.Handle(i)
+		l.MoveN(e, e.Value)
+	}
+
+	zero := l.Handle(5)
+	if expected, actual := []int{0, 3, -2, 1, 2, -3, 4}, l.Values(zero); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+
+	sum := 0
+	for _, n := range []int{1000, 2000, 3000} {
+		sum += zero.Move(n).Value
+	}
+	if sum != 3 {
+		t.Errorf("grove coordinates; expected: 3, actual: %d\n", sum)
+	}
+}
+
+func TestListMoveNWrap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{name: "zero", n: 0, expected: []int{1, 2, 3, 4}},
+		{name: "forward", n: 2, expected: []int{1, 3, 4, 2}},
+		{name: "backward", n: -1, expected: []int{1, 3, 4, 2}},
+		{name: "full cycle", n: 3, expected: []int{1, 2, 3, 4}},
+		{name: "large", n: 3*1000 + 1, expected: []int{1, 3, 2, 4}},
+		{name: "large negative", n: -3*1000 - 2, expected: []int{1, 3, 2, 4}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := New(1, 2, 3, 4)
+			l.MoveN(l.Handle(1), tc.n)
+			if actual := l.Values(l.Handle(0)); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	var l List[int]
+	one := l.PushBack(1)
+	three := l.PushBack(3)
+	l.InsertAfter(2, one)
+	l.InsertBefore(0, one)
+	l.PushBack(4)
+
+	if expected, actual := "RingList[1 2 3 0 4]", l.String(); actual != expected {
+		t.Errorf("\nexpected: %s\nactual: %s\n", expected, actual)
+	}
+	if l.Front() != one || l.Back().Value != 4 || one.Prev().Value != 4 {
+		t.Errorf("unexpected front or back for %v\n", &l)
+	}
+
+	l.MoveAfter(three, l.Back())
+	if v := l.Remove(one); v != 1 {
+		t.Errorf("l.Remove(); expected: 1, actual: %d\n", v)
+	}
+	if one.Next() != nil || l.Handle(0) != one {
+		t.Error("expected the removed element to be detached")
+	}
+	if expected, actual := []int{2, 0, 4, 3}, l.Values(l.Front()); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if l.Len() != 4 {
+		t.Errorf("l.Len(); expected: 4, actual: %d\n", l.Len())
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected a panic for an element not in the list")
+		}
+	}()
+	l.MoveAfter(one, l.Front())
+}
@@ -0,0 +1,111 @@
+// Package search implements the search algorithms over a range of integers
+// like the binary search on a predicate and the ternary search for the
+// extremum of a unimodal function, and over the implicit state spaces defined
+// by a successor function like the bidirectional and iterative deepening
+// search.
+//
+// These are useful for the puzzles asking for the minimal value satisfying a
+// condition where a linear scan over the range is too slow, or the minimal
+// number of steps to reach a state where the state space is too large for a
+// plain breadth first search.
+package search
+
+import (
+	"cmp"
+	"math"
+)
+
+// BinarySearch returns the smallest x in the half-open range [lo, hi) for
+// which pred returns true. The predicate must be monotonic over the range,
+// i.e., once it's true for some x, it's also true for every integer after x.
+// The boolean is false if pred is false for every integer in the range.
+func BinarySearch(lo, hi int, pred func(x int) bool) (int, bool) {
+	end := hi
+	for lo < hi {
+		// The difference is computed as unsigned to avoid the overflow for a
+		// range spanning more than half of int.
+		mid := lo + int((uint(hi)-uint(lo))>>1)
+		if pred(mid) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo, lo < end
+}
+
+// ExponentialSearch is similar to BinarySearch but for an unbounded range
+// starting at lo. It first finds an upper bound by doubling the distance from
+// lo until pred is true and then binary searches in the last interval. This
+// takes O(log d) calls to pred where d is the distance of the result from lo.
+//
+// The boolean is false if pred is false for every integer up to the maximum
+// value of int.
+func ExponentialSearch(lo int, pred func(x int) bool) (int, bool) {
+	if pred(lo) {
+		return lo, true
+	}
+	prev := lo
+	for step := 1; ; step *= 2 {
+		// The next bound would overflow, so search everything after prev.
+		if (lo >= 0 && step > math.MaxInt-lo) || step > math.MaxInt/2 {
+			if !pred(math.MaxInt) {
+				return 0, false
+			}
+			x, _ := BinarySearch(prev+1, math.MaxInt, pred)
+			return x, true
+		}
+		next := lo + step
+		if pred(next) {
+			x, _ := BinarySearch(prev+1, next, pred)
+			return x, true
+		}
+		prev = next
+	}
+}
+
+// TernarySearch returns the x in the closed range [lo, hi] which minimizes
+// f along with the value of f at it. The function must be unimodal over the
+// range, i.e., strictly decreasing and then strictly increasing, or convex,
+// which also allows a flat region at the minimum. It panics if lo is greater
+// than hi.
+//
+// Use TernarySearchMax to find the maximum.
+func TernarySearch[T cmp.Ordered](lo, hi int, f func(x int) T) (int, T) {
+	return ternarySearch(lo, hi, f, func(a, b T) bool { return a < b })
+}
+
+// TernarySearchMax is similar to TernarySearch except that it returns the x
+// which maximizes f.
+func TernarySearchMax[T cmp.Ordered](lo, hi int, f func(x int) T) (int, T) {
+	return ternarySearch(lo, hi, f, func(a, b T) bool { return a > b })
+}
+
+// ternarySearch returns the x in [lo, hi] whose value is better than every
+// other as reported by the better function.
+func ternarySearch[T cmp.Ordered](lo, hi int, f func(x int) T, better func(a, b T) bool) (int, T) {
+	if lo > hi {
+		panic("search: empty range")
+	}
+	for hi-lo > 2 {
+		m1 := lo + (hi-lo)/3
+		m2 := hi - (hi-lo)/3
+		f1, f2 := f(m1), f(m2)
+		switch {
+		case better(f1, f2):
+			hi = m2 - 1
+		case better(f2, f1):
+			lo = m1 + 1
+		default:
+			// The extremum is between the two points, inclusive.
+			lo, hi = m1, m2
+		}
+	}
+	x, fx := lo, f(lo)
+	for i := lo + 1; i <= hi; i++ {
+		if fi := f(i); better(fi, fx) {
+			x, fx = i, fi
+		}
+	}
+	return x, fx
+}
@@ -0,0 +1,107 @@
+package search
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBinarySearch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		lo, hi   int
+		target   int
+		expected int
+		ok       bool
+	}{
+		{name: "middle", lo: 0, hi: 100, target: 42, expected: 42, ok: true},
+		{name: "first", lo: -10, hi: 10, target: -50, expected: -10, ok: true},
+		{name: "none", lo: 0, hi: 10, target: 10, expected: 10, ok: false},
+		{name: "empty", lo: 5, hi: 5, target: 0, expected: 5, ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x, ok := BinarySearch(tc.lo, tc.hi, func(x int) bool { return x >= tc.target })
+			if x != tc.expected || ok != tc.ok {
+				t.Errorf("\nexpected: %d (%t)\nactual: %d (%t)\n", tc.expected, tc.ok, x, ok)
+			}
+		})
+	}
+}
+
+func TestExponentialSearch(t *testing.T) {
+	testCases := []struct {
+		name   string
+		lo     int
+		target int
+		ok     bool
+	}{
+		{name: "start", lo: 3, target: 3, ok: true},
+		{name: "near", lo: 0, target: 5, ok: true},
+		{name: "far", lo: -1000, target: 1 << 40, ok: true},
+		{name: "max", lo: math.MinInt, target: math.MaxInt, ok: true},
+		{name: "near max", lo: 10, target: math.MaxInt - 3, ok: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			x, ok := ExponentialSearch(tc.lo, func(x int) bool {
+				calls++
+				return x >= tc.target
+			})
+			if x != tc.target || ok != tc.ok {
+				t.Errorf("\nexpected: %d (%t)\nactual: %d (%t)\n", tc.target, tc.ok, x, ok)
+			}
+			if calls > 200 {
+				t.Errorf("expected a logarithmic number of calls, got %d\n", calls)
+			}
+		})
+	}
+
+	if _, ok := ExponentialSearch(0, func(x int) bool { return false }); ok {
+		t.Error("ExponentialSearch(); expected false when pred is never true")
+	}
+}
+
+func TestTernarySearch(t *testing.T) {
+	// 2021/07 sample where the fuel cost is the distance to every crab.
+	crabs := []int{16, 1, 2, 0, 4, 2, 7, 1, 2, 14}
+	fuel := func(cost func(d int) int) func(int) int {
+		return func(p int) int {
+			total := 0
+			for _, c := range crabs {
+				d := c - p
+				if d < 0 {
+					d = -d
+				}
+				total += cost(d)
+			}
+			return total
+		}
+	}
+
+	testCases := []struct {
+		name string
+		f    func(int) int
+		x    int
+		fx   int
+	}{
+		{name: "linear", f: fuel(func(d int) int { return d }), x: 2, fx: 37},
+		{name: "triangular", f: fuel(func(d int) int { return d * (d + 1) / 2 }), x: 5, fx: 168},
+		{name: "flat", f: func(x int) int { return max(0, x-60, 40-x) }, x: 40, fx: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			x, fx := TernarySearch(0, 100, tc.f)
+			if fx != tc.fx || tc.f(tc.x) != fx {
+				t.Errorf("\nexpected: %d at %d\nactual: %d at %d\n", tc.fx, tc.x, fx, x)
+			}
+		})
+	}
+
+	if x, fx := TernarySearchMax(-50, 50, func(x int) float64 { return -float64((x - 7) * (x - 7)) }); x != 7 || fx != 0 {
+		t.Errorf("TernarySearchMax(); expected: 0 at 7, actual: %v at %d\n", fx, x)
+	}
+}
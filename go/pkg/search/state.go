@@ -0,0 +1,142 @@
+package search
+
+import "slices"
+
+// BidirectionalBFS finds the shortest path from start to goal in an implicit
+// state space by running a breadth first search from both the ends until
+// they meet. The successors function returns the states reachable from a
+// state in a single step while predecessors returns the states from which a
+// state can be reached in a single step. For a state space where every step
+// can be reversed, successors can be used for both.
+//
+// It returns the number of steps between start and goal, along with the path
+// from start to goal, both inclusive. The ok value is false if goal is not
+// reachable from start.
+//
+// This visits far fewer states than a BFS from start when the state space
+// branches out quickly, as both the searches only need to go half as deep.
+func BidirectionalBFS[S comparable](start, goal S, successors, predecessors func(S) []S) (dist int, path []S, ok bool) {
+	if start == goal {
+		return 0, []S{start}, true
+	}
+
+	// prev maps a state reached by the forward search to the state it was
+	// reached from, and next maps a state reached by the backward search to
+	// the state it leads to.
+	prev := map[S]visit[S]{start: {parent: start}}
+	next := map[S]visit[S]{goal: {parent: goal}}
+	forward, backward := []S{start}, []S{goal}
+
+	for len(forward) > 0 && len(backward) > 0 {
+		// Always expand the smaller frontier.
+		expandForward := len(forward) <= len(backward)
+		frontier, visited, other, step := forward, prev, next, successors
+		if !expandForward {
+			frontier, visited, other, step = backward, next, prev, predecessors
+		}
+
+		var (
+			level []S
+			meet  S
+			found bool
+		)
+		for _, s := range frontier {
+			for _, n := range step(s) {
+				if _, seen := visited[n]; seen {
+					continue
+				}
+				visited[n] = visit[S]{parent: s, dist: visited[s].dist + 1}
+				level = append(level, n)
+				// Every state in this level is at the same distance from this
+				// side but not from the other side, so the closest one to the
+				// other side gives the shortest path.
+				if v, seen := other[n]; seen && (!found || v.dist < other[meet].dist) {
+					meet, found = n, true
+				}
+			}
+		}
+
+		if found {
+			path = joinPath(prev, next, start, goal, meet)
+			return len(path) - 1, path, true
+		}
+		if expandForward {
+			forward = level
+		} else {
+			backward = level
+		}
+	}
+
+	return 0, nil, false
+}
+
+// visit contains the information regarding a state visited by the search.
+type visit[S any] struct {
+	// parent is the state from which this state was reached.
+	parent S
+	// dist is the number of steps from the start of the search.
+	dist int
+}
+
+// joinPath builds the path from start to goal passing through the meeting
+// state using the maps from the forward and the backward search.
+func joinPath[S comparable](prev, next map[S]visit[S], start, goal, meet S) []S {
+	var path []S
+	for s := meet; s != start; s = prev[s].parent {
+		path = append(path, s)
+	}
+	path = append(path, start)
+	slices.Reverse(path)
+	for s := meet; s != goal; {
+		s = next[s].parent
+		path = append(path, s)
+	}
+	return path
+}
+
+// IDDFS performs an iterative deepening depth first search from the start
+// state until a state for which the goal function returns true is found. The
+// depth limit starts at 0 and is increased by one after every unsuccessful
+// search, up to maxDepth, so the first goal found is at the minimum depth.
+//
+// It returns the number of steps between start and the goal state, along
+// with the path from start to goal, both inclusive. The ok value is false if
+// no goal state is reachable within maxDepth steps.
+//
+// Unlike BFS, this only keeps the current path in memory. A state is not
+// revisited if it's already on the current path, but may be visited multiple
+// times through different paths.
+func IDDFS[S comparable](start S, goal func(S) bool, successors func(S) []S, maxDepth int) (dist int, path []S, ok bool) {
+	onPath := make(map[S]bool)
+	path = []S{start}
+
+	var dfs func(s S, depth int) bool
+	dfs = func(s S, depth int) bool {
+		if goal(s) {
+			return true
+		}
+		if depth == 0 {
+			return false
+		}
+		onPath[s] = true
+		defer delete(onPath, s)
+		for _, n := range successors(s) {
+			if onPath[n] {
+				continue
+			}
+			path = append(path, n)
+			if dfs(n, depth-1) {
+				return true
+			}
+			path = path[:len(path)-1]
+		}
+		return false
+	}
+
+	for depth := 0; depth <= maxDepth; depth++ {
+		if dfs(start, depth) {
+			return len(path) - 1, path, true
+		}
+	}
+	return 0, nil, false
+}
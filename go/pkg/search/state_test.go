@@ -0,0 +1,105 @@
+package search
+
+import (
+	"slices"
+	"testing"
+)
+
+// lockSuccessors returns the states of a four wheel combination lock after
+// turning a single wheel by one slot in either direction, skipping the given
+// dead ends.
+func lockSuccessors(deadends ...string) func(string) []string {
+	return func(s string) []string {
+		var next []string
+		for i := range s {
+			for _, d := range []byte{1, 9} {
+				b := []byte(s)
+				b[i] = (b[i]-'0'+d)%10 + '0'
+				if n := string(b); !slices.Contains(deadends, n) {
+					next = append(next, n)
+				}
+			}
+		}
+		return next
+	}
+}
+
+func TestBidirectionalBFS(t *testing.T) {
+	successors := lockSuccessors("0201", "0101", "0102", "1212", "2002")
+
+	testCases := []struct {
+		name        string
+		start, goal string
+		dist        int
+		ok          bool
+	}{
+		{name: "same", start: "0000", goal: "0000", dist: 0, ok: true},
+		{name: "around dead ends", start: "0000", goal: "0202", dist: 6, ok: true},
+		{name: "wrap", start: "0000", goal: "9999", dist: 4, ok: true},
+		{name: "far", start: "0000", goal: "5555", dist: 20, ok: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dist, path, ok := BidirectionalBFS(tc.start, tc.goal, successors, successors)
+			if dist != tc.dist || ok != tc.ok {
+				t.Fatalf("\nexpected: %d (%t)\nactual: %d (%t)\n", tc.dist, tc.ok, dist, ok)
+			}
+			checkPath(t, path, tc.start, tc.goal, dist, successors)
+		})
+	}
+
+	blocked := lockSuccessors("1000", "9000", "0100", "0900", "0010", "0090", "0001", "0009")
+	if _, _, ok := BidirectionalBFS("0000", "8888", blocked, blocked); ok {
+		t.Error("BidirectionalBFS(); expected no path when the start is blocked")
+	}
+}
+
+func TestBidirectionalBFSDirected(t *testing.T) {
+	// Every step either adds one or doubles the number.
+	successors := func(n int) []int {
+		return []int{n + 1, n * 2}
+	}
+	predecessors := func(n int) []int {
+		prev := []int{n - 1}
+		if n%2 == 0 {
+			prev = append(prev, n/2)
+		}
+		return prev
+	}
+	dist, path, ok := BidirectionalBFS(1, 100, successors, predecessors)
+	// 1 -> 2 -> 3 -> 6 -> 12 -> 24 -> 25 -> 50 -> 100
+	if !ok || dist != 8 {
+		t.Fatalf("expected: 8, actual: %d (%t)\n", dist, ok)
+	}
+	checkPath(t, path, 1, 100, dist, successors)
+}
+
+func TestIDDFS(t *testing.T) {
+	successors := lockSuccessors("0201", "0101", "0102", "1212", "2002")
+	goal := func(s string) bool { return s == "0202" }
+
+	dist, path, ok := IDDFS("0000", goal, successors, 10)
+	if !ok || dist != 6 {
+		t.Fatalf("expected: 6, actual: %d (%t)\n", dist, ok)
+	}
+	checkPath(t, path, "0000", "0202", dist, successors)
+
+	if _, _, ok := IDDFS("0000", goal, successors, 5); ok {
+		t.Error("IDDFS(); expected no path within 5 steps")
+	}
+}
+
+// checkPath checks that the path goes from start to goal in dist steps where
+// every step is a successor of the previous state.
+func checkPath[S comparable](t *testing.T, path []S, start, goal S, dist int, successors func(S) []S) {
+	t.Helper()
+	if len(path) != dist+1 || path[0] != start || path[len(path)-1] != goal {
+		t.Fatalf("invalid path from %v to %v: %v\n", start, goal, path)
+	}
+	for i := 1; i < len(path); i++ {
+		if !slices.Contains(successors(path[i-1]), path[i]) {
+			t.Fatalf("invalid step from %v to %v in path: %v\n", path[i-1], path[i], path)
+		}
+	}
+}
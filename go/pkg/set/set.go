@@ -5,6 +5,7 @@ package set
 
 import (
 	"fmt"
+	"iter"
 	"strings"
 )
 
@@ -31,6 +32,39 @@ func NewFromSlice[T comparable](sl []T) Set[T] {
 	return s
 }
 
+// NewFromSeq create and returns a new set from the elements yielded by the
+// given sequence.
+func NewFromSeq[T comparable](seq iter.Seq[T]) Set[T] {
+	s := New[T]()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
+
+// NewFromKeys create and returns a new set from the keys of an existing map.
+// The values of the map are ignored.
+func NewFromKeys[T comparable, V any](m map[T]V) Set[T] {
+	s := NewWithSize[T](len(m))
+	for e := range m {
+		s.Add(e)
+	}
+	return s
+}
+
+// Collect create and returns a new set from the keys yielded by the given
+// sequence of key-value pairs. The values are ignored.
+//
+// This is useful to collect the keys from an iterator like maps.All or
+// any other iter.Seq2 where only the first value is of interest.
+func Collect[T comparable, V any](seq iter.Seq2[T, V]) Set[T] {
+	s := New[T]()
+	for e := range seq {
+		s.Add(e)
+	}
+	return s
+}
+
 // Add adds all the given elements to the set. If any element is already in
 // the set, Add is a no-op for that element.
 func (s Set[T]) Add(es ...T) {
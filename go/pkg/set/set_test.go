@@ -1,6 +1,8 @@
 package set
 
 import (
+	"maps"
+	"slices"
 	"testing"
 )
 
@@ -105,3 +107,20 @@ func TestSetUnion(t *testing.T) {
 		t.Errorf("union of sets with common elements: %v\n", s)
 	}
 }
+
+func TestSetNewFromSeq(t *testing.T) {
+	s := NewFromSeq(slices.Values([]int{1, 2, 2, 3}))
+	if !s.IsEqual(New(1, 2, 3)) {
+		t.Errorf("new set from seq; expected: %v, actual: %v\n", New(1, 2, 3), s)
+	}
+
+	s = NewFromKeys(map[int]string{1: "a", 2: "b"})
+	if !s.IsEqual(New(1, 2)) {
+		t.Errorf("new set from keys; expected: %v, actual: %v\n", New(1, 2), s)
+	}
+
+	s = Collect(maps.All(map[int]bool{4: true, 5: false}))
+	if !s.IsEqual(New(4, 5)) {
+		t.Errorf("collect set from seq2; expected: %v, actual: %v\n", New(4, 5), s)
+	}
+}
@@ -0,0 +1,234 @@
+// Package shape implements small two dimensional shapes, like the polyominoes,
+// which can be moved around, rotated, flipped and placed onto a grid.
+package shape
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/grid"
+)
+
+// Shape is an immutable set of points which is normalized such that the
+// minimum X and Y coordinates are both 0, so the top-left corner of the
+// bounding box of the shape is at the origin. The orientation is the same as
+// the grid package, i.e., Y increasing downwards.
+//
+// A shape is placed somewhere by an offset which is added to every point.
+type Shape struct {
+	// points is sorted in reading order, i.e., by Y and then X.
+	points        []geom.Point2D[int]
+	width, height int
+}
+
+// New returns a new shape consisting of the given points, normalized by
+// moving them toward the origin. The duplicate points are ignored.
+func New(points ...geom.Point2D[int]) Shape {
+	if len(points) == 0 {
+		return Shape{}
+	}
+	bbox := geom.NewBoundingBox2DFromPoints(points)
+	normalized := make([]geom.Point2D[int], len(points))
+	for i, p := range points {
+		normalized[i] = geom.Point2D[int]{X: p.X - bbox.MinX, Y: p.Y - bbox.MinY}
+	}
+	slices.SortFunc(normalized, readingOrder)
+	return Shape{
+		points: slices.Compact(normalized),
+		width:  bbox.MaxX - bbox.MinX + 1,
+		height: bbox.MaxY - bbox.MinY + 1,
+	}
+}
+
+// Parse returns a new shape from the given lines where every position of the
+// on rune is a point of the shape. For example, the following is a plus:
+//
+//	.#.
+//	###
+//	.#.
+func Parse(lines []string, on rune) Shape {
+	var points []geom.Point2D[int]
+	for y, line := range lines {
+		for x, r := range []rune(line) {
+			if r == on {
+				points = append(points, geom.Point2D[int]{X: x, Y: y})
+			}
+		}
+	}
+	return New(points...)
+}
+
+// Len returns the number of points in the shape.
+func (s Shape) Len() int {
+	return len(s.points)
+}
+
+// Width returns the width of the bounding box of the shape.
+func (s Shape) Width() int {
+	return s.width
+}
+
+// Height returns the height of the bounding box of the shape.
+func (s Shape) Height() int {
+	return s.height
+}
+
+// Points returns the points of the shape in reading order. The returned
+// slice must not be modified.
+func (s Shape) Points() []geom.Point2D[int] {
+	return s.points
+}
+
+// At returns the points of the shape when placed at the given offset.
+func (s Shape) At(offset geom.Point2D[int]) []geom.Point2D[int] {
+	points := make([]geom.Point2D[int], len(s.points))
+	for i, p := range s.points {
+		points[i] = p.Add(offset)
+	}
+	return points
+}
+
+// Contains reports whether the point p is part of the shape.
+func (s Shape) Contains(p geom.Point2D[int]) bool {
+	_, found := slices.BinarySearchFunc(s.points, p, readingOrder)
+	return found
+}
+
+// Equal reports whether both the shapes consist of the same points.
+func (s Shape) Equal(other Shape) bool {
+	return slices.Equal(s.points, other.points)
+}
+
+// Rotate returns a new shape which is the shape rotated 90 degrees clockwise.
+func (s Shape) Rotate() Shape {
+	return s.transform(func(p geom.Point2D[int]) geom.Point2D[int] {
+		return geom.Point2D[int]{X: s.height - 1 - p.Y, Y: p.X}
+	})
+}
+
+// FlipH returns a new shape which is the mirror image of the shape across
+// the vertical axis, i.e., the left and right sides are swapped.
+func (s Shape) FlipH() Shape {
+	return s.transform(func(p geom.Point2D[int]) geom.Point2D[int] {
+		return geom.Point2D[int]{X: s.width - 1 - p.X, Y: p.Y}
+	})
+}
+
+// FlipV returns a new shape which is the mirror image of the shape across
+// the horizontal axis, i.e., the top and bottom sides are swapped.
+func (s Shape) FlipV() Shape {
+	return s.transform(func(p geom.Point2D[int]) geom.Point2D[int] {
+		return geom.Point2D[int]{X: p.X, Y: s.height - 1 - p.Y}
+	})
+}
+
+// Rotations returns all the distinct shapes formed by rotating the shape,
+// starting with the shape itself. There are 1, 2 or 4 of them depending on
+// the symmetry of the shape.
+func (s Shape) Rotations() []Shape {
+	var shapes []Shape
+	for r, i := s, 0; i < 4; r, i = r.Rotate(), i+1 {
+		shapes = appendDistinct(shapes, r)
+	}
+	return shapes
+}
+
+// Orientations returns all the distinct shapes formed by rotating and
+// flipping the shape, starting with the shape itself. There are at most 8 of
+// them.
+func (s Shape) Orientations() []Shape {
+	shapes := s.Rotations()
+	for _, r := range s.FlipH().Rotations() {
+		shapes = appendDistinct(shapes, r)
+	}
+	return shapes
+}
+
+// Overlaps reports whether the shape placed at offset has any point in common
+// with the other shape placed at otherOffset.
+func (s Shape) Overlaps(offset geom.Point2D[int], other Shape, otherOffset geom.Point2D[int]) bool {
+	delta := offset.Sub(otherOffset)
+	for _, p := range s.points {
+		if other.Contains(p.Add(delta)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Rows returns the shape as bitmasks of the given width which are ordered
+// from the top row to the bottom one. The shape is placed at column x where
+// the leftmost column is the most significant bit, so the masks read the
+// same as the shape when written in binary. The boolean is false if the
+// shape does not fit in the width at x.
+func (s Shape) Rows(width, x int) ([]int, bool) {
+	if x < 0 || x+s.width > width {
+		return nil, false
+	}
+	rows := make([]int, s.height)
+	for _, p := range s.points {
+		rows[p.Y] |= 1 << (width - 1 - x - p.X)
+	}
+	return rows, true
+}
+
+// Fits reports whether the shape placed at offset is within the grid and
+// every point of the grid it covers satisfies free.
+func Fits[T any](g *grid.Grid[T], s Shape, offset geom.Point2D[int], free func(v T) bool) bool {
+	for _, p := range s.points {
+		if v, ok := g.AtOk(p.Add(offset)); !ok || !free(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Stamp sets every point of the grid covered by the shape placed at offset
+// to v. The points outside the grid are ignored.
+func Stamp[T any](g *grid.Grid[T], s Shape, offset geom.Point2D[int], v T) {
+	for _, p := range s.points {
+		if p = p.Add(offset); g.InBounds(p) {
+			g.Set(p, v)
+		}
+	}
+}
+
+// String returns the shape drawn within its bounding box where a point of
+// the shape is a '#' and the rest are '.'.
+func (s Shape) String() string {
+	var sb strings.Builder
+	for y := 0; y < s.height; y++ {
+		for x := 0; x < s.width; x++ {
+			if s.Contains(geom.Point2D[int]{X: x, Y: y}) {
+				sb.WriteByte('#')
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// transform returns a new shape with fn applied to every point.
+func (s Shape) transform(fn func(p geom.Point2D[int]) geom.Point2D[int]) Shape {
+	points := make([]geom.Point2D[int], len(s.points))
+	for i, p := range s.points {
+		points[i] = fn(p)
+	}
+	return New(points...)
+}
+
+// appendDistinct appends s to shapes if it's not already in there.
+func appendDistinct(shapes []Shape, s Shape) []Shape {
+	if slices.ContainsFunc(shapes, s.Equal) {
+		return shapes
+	}
+	return append(shapes, s)
+}
+
+func readingOrder(a, b geom.Point2D[int]) int {
+	return cmp.Or(cmp.Compare(a.Y, b.Y), cmp.Compare(a.X, b.X))
+}
@@ -0,0 +1,139 @@
+package shape
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/grid"
+)
+
+var lShape = Parse([]string{
+	"#.",
+	"#.",
+	"##",
+}, '#')
+
+func TestNew(t *testing.T) {
+	s := New(geom.Point2D[int]{X: 5, Y: -2}, geom.Point2D[int]{X: 4, Y: -1}, geom.Point2D[int]{X: 5, Y: -2})
+	expected := []geom.Point2D[int]{{X: 1, Y: 0}, {X: 0, Y: 1}}
+	if !reflect.DeepEqual(s.Points(), expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, s.Points())
+	}
+	if s.Width() != 2 || s.Height() != 2 || s.Len() != 2 {
+		t.Errorf("unexpected dimensions: %dx%d with %d points\n", s.Width(), s.Height(), s.Len())
+	}
+	if empty := New(); empty.Len() != 0 || empty.String() != "" {
+		t.Errorf("expected an empty shape, actual: %q\n", empty.String())
+	}
+}
+
+func TestTransform(t *testing.T) {
+	testCases := []struct {
+		name     string
+		actual   Shape
+		expected string
+	}{
+		{name: "identity", actual: lShape, expected: "#.\n#.\n##\n"},
+		{name: "rotate", actual: lShape.Rotate(), expected: "###\n#..\n"},
+		{name: "rotate twice", actual: lShape.Rotate().Rotate(), expected: "##\n.#\n.#\n"},
+		{name: "flip horizontal", actual: lShape.FlipH(), expected: ".#\n.#\n##\n"},
+		{name: "flip vertical", actual: lShape.FlipV(), expected: "##\n#.\n#.\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.actual.String(); actual != tc.expected {
+				t.Errorf("\nexpected: %q\nactual: %q\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestOrientations(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		shape                   Shape
+		rotations, orientations int
+	}{
+		{name: "square", shape: Parse([]string{"##", "##"}, '#'), rotations: 1, orientations: 1},
+		{name: "line", shape: Parse([]string{"####"}, '#'), rotations: 2, orientations: 2},
+		{name: "S", shape: Parse([]string{".##", "##."}, '#'), rotations: 2, orientations: 4},
+		{name: "T", shape: Parse([]string{"###", ".#."}, '#'), rotations: 4, orientations: 4},
+		{name: "L", shape: lShape, rotations: 4, orientations: 8},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if n := len(tc.shape.Rotations()); n != tc.rotations {
+				t.Errorf("Rotations(); expected: %d, actual: %d\n", tc.rotations, n)
+			}
+			orientations := tc.shape.Orientations()
+			if n := len(orientations); n != tc.orientations {
+				t.Errorf("Orientations(); expected: %d, actual: %d\n", tc.orientations, n)
+			}
+			if !orientations[0].Equal(tc.shape) {
+				t.Errorf("expected the first orientation to be the shape itself\n")
+			}
+		})
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	square := Parse([]string{"##", "##"}, '#')
+	origin := geom.Point2D[int]{X: 0, Y: 0}
+
+	testCases := []struct {
+		name     string
+		offset   geom.Point2D[int]
+		expected bool
+	}{
+		{name: "same", offset: origin, expected: true},
+		{name: "corner", offset: geom.Point2D[int]{X: 1, Y: 2}, expected: true},
+		{name: "notch", offset: geom.Point2D[int]{X: 1, Y: 0}, expected: false},
+		{name: "apart", offset: geom.Point2D[int]{X: 5, Y: 5}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := square.Overlaps(tc.offset, lShape, origin); actual != tc.expected {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRows(t *testing.T) {
+	rows, ok := lShape.Rows(7, 2)
+	if expected := []int{0b0010000, 0b0010000, 0b0011000}; !ok || !reflect.DeepEqual(rows, expected) {
+		t.Errorf("\nexpected: %b\nactual: %b\n", expected, rows)
+	}
+	if _, ok := lShape.Rows(7, 6); ok {
+		t.Error("expected the shape to not fit at the right edge")
+	}
+}
+
+func TestFitsAndStamp(t *testing.T) {
+	g := grid.FromLines([]string{
+		"....",
+		"..#.",
+		"....",
+	})
+	free := func(v byte) bool { return v == '.' }
+
+	if !Fits(g, lShape, geom.Point2D[int]{X: 0, Y: 0}, free) {
+		t.Error("expected the shape to fit at the origin")
+	}
+	if Fits(g, lShape, geom.Point2D[int]{X: 2, Y: 0}, free) {
+		t.Error("expected the shape to collide with the wall")
+	}
+	if Fits(g, lShape, geom.Point2D[int]{X: 3, Y: 0}, free) {
+		t.Error("expected the shape to be out of bounds")
+	}
+
+	Stamp(g, lShape, geom.Point2D[int]{X: 0, Y: 0}, 'L')
+	actual := g.Format(func(_ geom.Point2D[int], v byte) string { return string(v) })
+	if expected := "L...\nL.#.\nLL.."; actual != expected {
+		t.Errorf("\nexpected: %q\nactual: %q\n", expected, actual)
+	}
+}
@@ -0,0 +1,196 @@
+// Package sparsegrid implements an unbounded two dimensional grid which only
+// stores the points whose value differs from a default value.
+//
+// Every point which was never set has the default value, which makes it
+// suitable for the puzzles with an infinite region around a finite area of
+// interest, like an infinite image or floor.
+package sparsegrid
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+)
+
+// Grid is an unbounded two dimensional grid with the same orientation as the
+// grid package, i.e., X increasing to the right and Y increasing downwards.
+type Grid[T comparable] struct {
+	cells map[geom.Point2D[int]]T
+	def   T
+
+	// bounds is the smallest bounding box containing all the cells, nil if
+	// there are no cells. It is recomputed lazily when it might have shrunk.
+	bounds *geom.BoundingBox2D
+	stale  bool
+}
+
+// New returns a new empty grid where every point has the given default value.
+func New[T comparable](def T) *Grid[T] {
+	return &Grid[T]{
+		cells: make(map[geom.Point2D[int]]T),
+		def:   def,
+	}
+}
+
+// Parse returns a new grid from the given lines where the rune at column X of
+// line Y is converted to the value of the point {X, Y} using the convert
+// function. The lines are not required to be of equal length.
+func Parse[T comparable](lines []string, def T, convert func(r rune) T) *Grid[T] {
+	g := New(def)
+	for y, line := range lines {
+		for x, r := range []rune(line) {
+			g.Set(geom.Point2D[int]{X: x, Y: y}, convert(r))
+		}
+	}
+	return g
+}
+
+// Default returns the value of every point which is not set in the grid.
+func (g *Grid[T]) Default() T {
+	return g.def
+}
+
+// SetDefault changes the value of every point which is not set in the grid.
+// The points which were explicitly set to the new default value are removed.
+func (g *Grid[T]) SetDefault(def T) {
+	g.def = def
+	for p, v := range g.cells {
+		if v == def {
+			g.Delete(p)
+		}
+	}
+}
+
+// Get returns the value at point p, which is the default value if p is not
+// set in the grid.
+func (g *Grid[T]) Get(p geom.Point2D[int]) T {
+	if v, ok := g.cells[p]; ok {
+		return v
+	}
+	return g.def
+}
+
+// Set sets the value at point p to v. Setting a point to the default value is
+// the same as deleting it.
+func (g *Grid[T]) Set(p geom.Point2D[int], v T) {
+	if v == g.def {
+		g.Delete(p)
+		return
+	}
+	g.cells[p] = v
+	switch {
+	case g.stale:
+	case g.bounds == nil:
+		g.bounds = geom.NewBoundingBox2D(p.X, p.X, p.Y, p.Y)
+	default:
+		g.bounds.MinX = min(g.bounds.MinX, p.X)
+		g.bounds.MaxX = max(g.bounds.MaxX, p.X)
+		g.bounds.MinY = min(g.bounds.MinY, p.Y)
+		g.bounds.MaxY = max(g.bounds.MaxY, p.Y)
+	}
+}
+
+// Delete resets the value at point p to the default value.
+func (g *Grid[T]) Delete(p geom.Point2D[int]) {
+	if _, ok := g.cells[p]; !ok {
+		return
+	}
+	delete(g.cells, p)
+	// Only the points on the border of the bounding box can shrink it.
+	if b := g.bounds; b != nil && (p.X == b.MinX || p.X == b.MaxX || p.Y == b.MinY || p.Y == b.MaxY) {
+		g.stale = true
+	}
+}
+
+// Len returns the number of points which are set to a value other than the
+// default value.
+func (g *Grid[T]) Len() int {
+	return len(g.cells)
+}
+
+// Bounds returns the smallest bounding box containing all the points which
+// are set to a value other than the default value. The boolean is false if
+// there are no such points. The returned box must not be modified.
+func (g *Grid[T]) Bounds() (*geom.BoundingBox2D, bool) {
+	if g.stale {
+		g.bounds = geom.NewBoundingBox2DFromPoints(slices.Collect(maps.Keys(g.cells)))
+		g.stale = false
+	}
+	return g.bounds, g.bounds != nil
+}
+
+// All returns an iterator over all the points, in no particular order, which
+// are set to a value other than the default value along with the value.
+func (g *Grid[T]) All() iter.Seq2[geom.Point2D[int], T] {
+	return maps.All(g.cells)
+}
+
+// Count returns the number of points, excluding the infinite region of the
+// default value, whose value satisfies pred.
+func (g *Grid[T]) Count(pred func(v T) bool) int {
+	count := 0
+	for _, v := range g.cells {
+		if pred(v) {
+			count++
+		}
+	}
+	return count
+}
+
+// Step returns a new grid with the given default value where the value of
+// every point inside the bounding box, grown by margin in every direction, is
+// computed using the rule function. This is useful for the cellular automata
+// where the rule only depends on the neighboring points, so the points too
+// far from the bounding box all follow the default value.
+//
+// The rule is always called on the old grid, so it can freely refer to the
+// values in it.
+func (g *Grid[T]) Step(margin int, def T, rule func(p geom.Point2D[int]) T) *Grid[T] {
+	next := New(def)
+	if bounds, ok := g.Bounds(); ok {
+		for p := range bounds.Expand(margin).Points() {
+			next.Set(p, rule(p))
+		}
+	}
+	return next
+}
+
+// Copy returns a copy of the grid.
+func (g *Grid[T]) Copy() *Grid[T] {
+	c := &Grid[T]{cells: maps.Clone(g.cells), def: g.def, stale: g.stale}
+	if g.bounds != nil {
+		b := *g.bounds
+		c.bounds = &b
+	}
+	return c
+}
+
+// Format returns the string representation of the area within the bounding
+// box, grown by margin in every direction, where every point is rendered
+// using the given function. Every row is terminated by a newline.
+func (g *Grid[T]) Format(margin int, render func(p geom.Point2D[int], v T) string) string {
+	bounds, ok := g.Bounds()
+	if !ok {
+		return ""
+	}
+	bounds = bounds.Expand(margin)
+
+	var sb strings.Builder
+	for p := range bounds.Points() {
+		sb.WriteString(render(p, g.Get(p)))
+		if p.X == bounds.MaxX {
+			sb.WriteByte('\n')
+		}
+	}
+	return sb.String()
+}
+
+func (g *Grid[T]) String() string {
+	return g.Format(0, func(_ geom.Point2D[int], v T) string {
+		return fmt.Sprint(v)
+	})
+}
@@ -0,0 +1,104 @@
+package sparsegrid
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+)
+
+func TestGrid(t *testing.T) {
+	g := New('.')
+	if _, ok := g.Bounds(); ok {
+		t.Error("expected an empty grid to have no bounds")
+	}
+
+	a, b := geom.Point2D[int]{X: -2, Y: 1}, geom.Point2D[int]{X: 3, Y: -4}
+	g.Set(a, '#')
+	g.Set(b, '#')
+	g.Set(geom.Point2D[int]{X: 0, Y: 0}, '.')
+	if g.Len() != 2 {
+		t.Errorf("g.Len(); expected: 2, actual: %d\n", g.Len())
+	}
+	if v := g.Get(geom.Point2D[int]{X: 100, Y: 100}); v != '.' {
+		t.Errorf("g.Get(100, 100); expected: ., actual: %c\n", v)
+	}
+	bounds, ok := g.Bounds()
+	if expected := geom.NewBoundingBox2D(-2, 3, -4, 1); !ok || !reflect.DeepEqual(bounds, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, bounds)
+	}
+
+	g.Delete(b)
+	bounds, ok = g.Bounds()
+	if expected := geom.NewBoundingBox2D(-2, -2, 1, 1); !ok || !reflect.DeepEqual(bounds, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, bounds)
+	}
+
+	g.Set(a, '.')
+	if _, ok := g.Bounds(); ok || g.Len() != 0 {
+		t.Error("expected setting the default value to delete the point")
+	}
+}
+
+func TestGridSetDefault(t *testing.T) {
+	g := Parse([]string{"#.", ".#"}, false, func(r rune) bool { return r == '#' })
+	if g.Len() != 2 {
+		t.Errorf("g.Len(); expected: 2, actual: %d\n", g.Len())
+	}
+
+	c := g.Copy()
+	g.SetDefault(true)
+	if g.Len() != 0 || !g.Get(geom.Point2D[int]{X: 1, Y: 0}) {
+		t.Errorf("expected all the points to follow the new default, actual: %d points\n", g.Len())
+	}
+	if c.Len() != 2 || c.Default() {
+		t.Error("g.Copy(); expected copy to be independent")
+	}
+}
+
+func TestGridStep(t *testing.T) {
+	// Every point becomes lit if any of its neighbors, including itself, is
+	// lit, which grows the lit region by one in every direction.
+	g := Parse([]string{"#"}, false, func(r rune) bool { return r == '#' })
+	g = g.Step(1, false, func(p geom.Point2D[int]) bool {
+		for _, d := range geom.AllDirections2D {
+			if g.Get(p.Add(d)) {
+				return true
+			}
+		}
+		return g.Get(p)
+	})
+	if g.Len() != 9 {
+		t.Errorf("g.Len(); expected: 9, actual: %d\n", g.Len())
+	}
+
+	empty := New(0).Step(1, 1, func(geom.Point2D[int]) int { return 2 })
+	if empty.Len() != 0 || empty.Default() != 1 {
+		t.Errorf("expected an empty grid with the new default, actual: %d points\n", empty.Len())
+	}
+}
+
+func TestGridFormat(t *testing.T) {
+	g := Parse([]string{"#..", "..#"}, '.', func(r rune) rune { return r })
+	render := func(_ geom.Point2D[int], v rune) string { return string(v) }
+
+	testCases := []struct {
+		name     string
+		margin   int
+		expected string
+	}{
+		{name: "bounds", margin: 0, expected: "#..\n..#\n"},
+		{name: "margin", margin: 1, expected: ".....\n.#...\n...#.\n.....\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := g.Format(tc.margin, render); actual != tc.expected {
+				t.Errorf("\nexpected: %q\nactual: %q\n", tc.expected, actual)
+			}
+		})
+	}
+	if actual := New(0).String(); actual != "" {
+		t.Errorf("\nexpected: %q\nactual: %q\n", "", actual)
+	}
+}
@@ -0,0 +1,106 @@
+package stack
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/constraints"
+)
+
+// minMaxEntry is an element on the MinMax stack along with the minimum and
+// maximum of all the elements at or below it.
+type minMaxEntry[T constraints.Ordered] struct {
+	value, min, max T
+}
+
+// MinMax is a stack which keeps track of the minimum and maximum element
+// currently on the stack, both of which can be retrieved in constant time.
+//
+// The zero value is an empty stack ready to use.
+type MinMax[T constraints.Ordered] struct {
+	entries []minMaxEntry[T]
+}
+
+// NewMinMax returns an initialized stack, optionally with the given elements.
+// The elements are pushed in the same order as provided.
+func NewMinMax[T constraints.Ordered](es ...T) *MinMax[T] {
+	s := new(MinMax[T])
+	s.Push(es...)
+	return s
+}
+
+// Push adds an element to the top of a stack. Multiple elements are added in
+// the same order as provided.
+func (s *MinMax[T]) Push(es ...T) {
+	for _, e := range es {
+		entry := minMaxEntry[T]{value: e, min: e, max: e}
+		if n := len(s.entries); n > 0 {
+			entry.min = min(e, s.entries[n-1].min)
+			entry.max = max(e, s.entries[n-1].max)
+		}
+		s.entries = append(s.entries, entry)
+	}
+}
+
+// Pop removes the top element on the stack and returns it.
+//
+// An attempt to pop when the stack is empty will return the zero value for
+// the type of the elements in the stack. Using multiple assignment, one can
+// distinguish a missing entry from a zero value. This is referred to as the
+// "comma ok" idiom.
+func (s *MinMax[T]) Pop() (e T, ok bool) {
+	n := len(s.entries)
+	if n == 0 {
+		return e, false
+	}
+	e = s.entries[n-1].value
+	s.entries = s.entries[:n-1]
+	return e, true
+}
+
+// Peek returns the top element on the stack without removing it, following
+// the same "comma ok" idiom as Pop.
+func (s *MinMax[T]) Peek() (e T, ok bool) {
+	n := len(s.entries)
+	if n == 0 {
+		return e, false
+	}
+	return s.entries[n-1].value, true
+}
+
+// Min returns the minimum element currently on the stack, following the same
+// "comma ok" idiom as Pop.
+func (s *MinMax[T]) Min() (e T, ok bool) {
+	n := len(s.entries)
+	if n == 0 {
+		return e, false
+	}
+	return s.entries[n-1].min, true
+}
+
+// Max returns the maximum element currently on the stack, following the same
+// "comma ok" idiom as Pop.
+func (s *MinMax[T]) Max() (e T, ok bool) {
+	n := len(s.entries)
+	if n == 0 {
+		return e, false
+	}
+	return s.entries[n-1].max, true
+}
+
+// Len returns the number of elements on the stack.
+func (s *MinMax[T]) Len() int {
+	return len(s.entries)
+}
+
+// IsEmpty is used to check whether the stack is empty or not.
+func (s *MinMax[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+func (s *MinMax[T]) String() string {
+	values := make([]T, len(s.entries))
+	for i, entry := range s.entries {
+		values[i] = entry.value
+	}
+	return fmt.Sprintf("MinMax%v", values)
+}
@@ -0,0 +1,44 @@
+package stack
+
+import "testing"
+
+func TestMinMax(t *testing.T) {
+	s := NewMinMax[int]()
+	if e, ok := s.Min(); ok {
+		t.Errorf("s.Min() empty stack; expected: nil, actual: %v\n", e)
+	}
+
+	testCases := []struct {
+		push     int
+		min, max int
+	}{
+		{push: 5, min: 5, max: 5},
+		{push: 7, min: 5, max: 7},
+		{push: 2, min: 2, max: 7},
+		{push: 9, min: 2, max: 9},
+		{push: 2, min: 2, max: 9},
+	}
+
+	for _, tc := range testCases {
+		s.Push(tc.push)
+		if e, _ := s.Min(); e != tc.min {
+			t.Errorf("s.Push(%d); expected min: %d, actual: %d\n", tc.push, tc.min, e)
+		}
+		if e, _ := s.Max(); e != tc.max {
+			t.Errorf("s.Push(%d); expected max: %d, actual: %d\n", tc.push, tc.max, e)
+		}
+	}
+
+	for i := len(testCases) - 1; i > 0; i-- {
+		if e, _ := s.Pop(); e != testCases[i].push {
+			t.Errorf("s.Pop(); expected: %d, actual: %d\n", testCases[i].push, e)
+		}
+		expected := testCases[i-1]
+		if mn, _ := s.Min(); mn != expected.min {
+			t.Errorf("s.Pop(); expected min: %d, actual: %d\n", expected.min, mn)
+		}
+		if mx, _ := s.Max(); mx != expected.max {
+			t.Errorf("s.Pop(); expected max: %d, actual: %d\n", expected.max, mx)
+		}
+	}
+}
@@ -1,7 +1,11 @@
 // Package stack implements a generic stack data structure.
 package stack
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
 
 // Stack represents the stack data structure.
 type Stack[T any] []T
@@ -68,6 +72,25 @@ func (s *Stack[T]) IsEmpty() bool {
 	return s.Len() == 0
 }
 
+// All returns an iterator over the elements of the stack from the bottom to
+// the top, without removing them.
+func (s *Stack[T]) All() iter.Seq[T] {
+	return slices.Values(*s)
+}
+
+// Clone returns a new stack containing the same elements as the stack.
+// Pushing or popping from either of them will not affect the other.
+func (s *Stack[T]) Clone() *Stack[T] {
+	return New(*s...)
+}
+
+// ToSlice returns a slice containing the elements of the stack where the first
+// element is the bottom and the last element is the top of the stack.
+// Mutating the returned slice will not affect the underlying implementation.
+func (s *Stack[T]) ToSlice() []T {
+	return slices.Clone(*s)
+}
+
 func (s *Stack[T]) String() string {
 	return fmt.Sprintf("Stack%v", *s)
 }
@@ -1,6 +1,10 @@
 package stack
 
-import "testing"
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
 
 func TestStackNew(t *testing.T) {
 	s := New[int]()
@@ -98,3 +102,27 @@ func TestStackEmpty(t *testing.T) {
 		t.Errorf("s.IsEmpty(); expected: false, actual: %v\n", b)
 	}
 }
+
+func TestStackAllClone(t *testing.T) {
+	s := New(1, 2, 3)
+
+	if actual := slices.Collect(s.All()); !reflect.DeepEqual(actual, []int{1, 2, 3}) {
+		t.Errorf("s.All(); expected: [1 2 3], actual: %v\n", actual)
+	}
+
+	c := s.Clone()
+	c.Push(4)
+	s.Pop()
+	if actual := s.ToSlice(); !reflect.DeepEqual(actual, []int{1, 2}) {
+		t.Errorf("s.ToSlice(); expected: [1 2], actual: %v\n", actual)
+	}
+	if actual := c.ToSlice(); !reflect.DeepEqual(actual, []int{1, 2, 3, 4}) {
+		t.Errorf("c.ToSlice(); expected: [1 2 3 4], actual: %v\n", actual)
+	}
+
+	sl := c.ToSlice()
+	sl[0] = 10
+	if e := (*c)[0]; e != 1 {
+		t.Errorf("mutating ToSlice result; expected bottom: 1, actual: %v\n", e)
+	}
+}
@@ -0,0 +1,269 @@
+// Package vm implements a register machine which can run the assembly-like
+// programs of the puzzles with a pluggable instruction set.
+//
+// A puzzle only needs to define the operations of its instruction set while
+// the machine takes care of parsing the program, the program counter, the
+// registers and the input and output.
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrUnknownOp is returned when the program contains an operation which
+	// is not in the instruction set.
+	ErrUnknownOp = errors.New("vm: unknown operation")
+
+	// ErrArity is returned when an instruction has a different number of
+	// arguments than its operation expects.
+	ErrArity = errors.New("vm: wrong number of arguments")
+
+	// ErrNoInput is returned when an instruction reads the input but there's
+	// no more input available.
+	ErrNoInput = errors.New("vm: no input available")
+)
+
+// Instruction is a single instruction of a program.
+type Instruction struct {
+	// Op is the name of the operation.
+	Op string
+	// Args are the arguments to the operation as they appear in the program.
+	Args []string
+}
+
+func (i Instruction) String() string {
+	return strings.Join(append([]string{i.Op}, i.Args...), " ")
+}
+
+// Op is a single operation in an instruction set.
+type Op struct {
+	// Arity is the number of arguments the operation expects.
+	Arity int
+	// Exec executes the operation with the given arguments on the machine.
+	// The program counter is advanced to the next instruction after Exec
+	// returns unless it jumps using Jump or JumpTo, or halts the machine.
+	Exec func(m *Machine, args []string) error
+}
+
+// InstructionSet is a map from the name of an operation to the operation.
+type InstructionSet map[string]Op
+
+// Parse parses the given lines into a program. Every line is an operation
+// name followed by its arguments, separated by white space. Commas after an
+// argument are ignored, so "jio a, +19" is parsed as "jio" with the arguments
+// "a" and "+19". Empty lines are skipped.
+func Parse(lines []string) []Instruction {
+	program := make([]Instruction, 0, len(lines))
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		args := fields[1:]
+		for i, arg := range args {
+			args[i] = strings.TrimSuffix(arg, ",")
+		}
+		program = append(program, Instruction{Op: fields[0], Args: args})
+	}
+	return program
+}
+
+// Machine is a register machine running a program.
+type Machine struct {
+	// Program is the program being run. The instructions can be modified
+	// while the machine is running, like for a self-modifying program.
+	Program []Instruction
+	// Registers contains the value of every register. A register which has
+	// not been written to has the value 0.
+	Registers map[string]int
+	// PC is the program counter, the index of the next instruction to run.
+	PC int
+	// Steps is the number of instructions executed so far.
+	Steps int
+
+	// Input is called by the operations reading the input using Read. It
+	// returns false if there's no more input.
+	Input func() (int, bool)
+	// Output is called by the operations writing the output using Write.
+	Output func(v int)
+
+	ops    InstructionSet
+	jumped bool
+	halted bool
+}
+
+// New returns a new machine running the given program with the instruction
+// set. All the registers are initialized to 0.
+func New(program []Instruction, ops InstructionSet) *Machine {
+	return &Machine{
+		Program:   program,
+		Registers: make(map[string]int),
+		ops:       ops,
+	}
+}
+
+// Value returns the value of the argument which is either an integer
+// literal, optionally signed, or the name of a register.
+func (m *Machine) Value(arg string) int {
+	if v, err := strconv.Atoi(arg); err == nil {
+		return v
+	}
+	return m.Registers[arg]
+}
+
+// IsRegister returns true if the argument is the name of a register, i.e.,
+// it's not an integer literal.
+func (m *Machine) IsRegister(arg string) bool {
+	_, err := strconv.Atoi(arg)
+	return err != nil
+}
+
+// Jump moves the program counter by the given offset relative to the
+// current instruction.
+func (m *Machine) Jump(offset int) {
+	m.PC += offset
+	m.jumped = true
+}
+
+// JumpTo sets the program counter to the given address.
+func (m *Machine) JumpTo(addr int) {
+	m.PC = addr
+	m.jumped = true
+}
+
+// Halt stops the machine after the current instruction.
+func (m *Machine) Halt() {
+	m.halted = true
+}
+
+// Halted returns true if the machine has stopped, either because it was
+// halted or the program counter is outside the program.
+func (m *Machine) Halted() bool {
+	return m.halted || m.PC < 0 || m.PC >= len(m.Program)
+}
+
+// Read returns the next input value using the Input hook. It returns
+// ErrNoInput if there's no input hook or no more input.
+func (m *Machine) Read() (int, error) {
+	if m.Input == nil {
+		return 0, ErrNoInput
+	}
+	v, ok := m.Input()
+	if !ok {
+		return 0, ErrNoInput
+	}
+	return v, nil
+}
+
+// Write passes the value to the Output hook, if any.
+func (m *Machine) Write(v int) {
+	if m.Output != nil {
+		m.Output(v)
+	}
+}
+
+// Step executes the instruction at the program counter. It does nothing if
+// the machine has halted.
+func (m *Machine) Step() error {
+	if m.Halted() {
+		return nil
+	}
+	inst := m.Program[m.PC]
+	op, ok := m.ops[inst.Op]
+	if !ok {
+		return fmt.Errorf("pc %d: %q: %w", m.PC, inst, ErrUnknownOp)
+	}
+	if len(inst.Args) != op.Arity {
+		return fmt.Errorf("pc %d: %q: %w (expected %d)", m.PC, inst, ErrArity, op.Arity)
+	}
+	m.jumped = false
+	if err := op.Exec(m, inst.Args); err != nil {
+		return fmt.Errorf("pc %d: %q: %w", m.PC, inst, err)
+	}
+	if !m.jumped && !m.halted {
+		m.PC++
+	}
+	m.Steps++
+	return nil
+}
+
+// Run executes the program until the machine halts.
+func (m *Machine) Run() error {
+	for !m.Halted() {
+		if err := m.Step(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunUntil is similar to Run except that it also stops before executing an
+// instruction if stop returns true for the machine. The boolean is true if
+// the machine was stopped because of it.
+//
+// This can be used to detect an infinite loop by stopping when an
+// instruction is about to be executed for the second time.
+func (m *Machine) RunUntil(stop func(m *Machine) bool) (bool, error) {
+	for !m.Halted() {
+		if stop(m) {
+			return true, nil
+		}
+		if err := m.Step(); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// Snapshot is the state of a machine at a point in time.
+type Snapshot struct {
+	Program   []Instruction
+	Registers map[string]int
+	PC        int
+	Steps     int
+	Halted    bool
+}
+
+// Snapshot returns a copy of the current state of the machine.
+func (m *Machine) Snapshot() Snapshot {
+	return Snapshot{
+		Program:   cloneProgram(m.Program),
+		Registers: maps.Clone(m.Registers),
+		PC:        m.PC,
+		Steps:     m.Steps,
+		Halted:    m.halted,
+	}
+}
+
+// Restore sets the state of the machine to the given snapshot. The snapshot
+// is copied, so it can be restored again later.
+func (m *Machine) Restore(s Snapshot) {
+	m.Program = cloneProgram(s.Program)
+	m.Registers = maps.Clone(s.Registers)
+	m.PC = s.PC
+	m.Steps = s.Steps
+	m.halted = s.Halted
+}
+
+// Reset sets the program counter, the steps and all the registers back to 0.
+// The program is not modified.
+func (m *Machine) Reset() {
+	clear(m.Registers)
+	m.PC = 0
+	m.Steps = 0
+	m.halted = false
+}
+
+func cloneProgram(program []Instruction) []Instruction {
+	clone := make([]Instruction, len(program))
+	for i, inst := range program {
+		clone[i] = Instruction{Op: inst.Op, Args: slices.Clone(inst.Args)}
+	}
+	return clone
+}
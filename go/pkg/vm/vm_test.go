@@ -0,0 +1,171 @@
+package vm
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// assembunny is the instruction set of the 2016 assembunny puzzles.
+var assembunny = InstructionSet{
+	"cpy": {Arity: 2, Exec: func(m *Machine, args []string) error {
+		m.Registers[args[1]] = m.Value(args[0])
+		return nil
+	}},
+	"inc": {Arity: 1, Exec: func(m *Machine, args []string) error {
+		m.Registers[args[0]]++
+		return nil
+	}},
+	"dec": {Arity: 1, Exec: func(m *Machine, args []string) error {
+		m.Registers[args[0]]--
+		return nil
+	}},
+	"jnz": {Arity: 2, Exec: func(m *Machine, args []string) error {
+		if m.Value(args[0]) != 0 {
+			m.Jump(m.Value(args[1]))
+		}
+		return nil
+	}},
+	"in": {Arity: 1, Exec: func(m *Machine, args []string) error {
+		v, err := m.Read()
+		m.Registers[args[0]] = v
+		return err
+	}},
+	"out": {Arity: 1, Exec: func(m *Machine, args []string) error {
+		m.Write(m.Value(args[0]))
+		return nil
+	}},
+	"hlt": {Arity: 0, Exec: func(m *Machine, args []string) error {
+		m.Halt()
+		return nil
+	}},
+}
+
+func TestMachineRun(t *testing.T) {
+	m := New(Parse([]string{"cpy 41 a", "inc a", "inc a", "dec a", "jnz a 2", "dec a"}), assembunny)
+	if err := m.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if m.Registers["a"] != 42 || m.Steps != 5 {
+		t.Errorf("expected a = 42 after 5 steps, got a = %d after %d steps\n", m.Registers["a"], m.Steps)
+	}
+}
+
+func TestMachineIO(t *testing.T) {
+	// Outputs the input values in reverse order until the input is 0.
+	program := Parse([]string{
+		"in a",
+		"jnz a 2",
+		"hlt",
+		"out a",
+		"jnz 1 -4",
+	})
+	inputs := []int{3, 1, 2, 0, 5}
+	var outputs []int
+
+	m := New(program, assembunny)
+	m.Input = func() (int, bool) {
+		if len(inputs) == 0 {
+			return 0, false
+		}
+		v := inputs[0]
+		inputs = inputs[1:]
+		return v, true
+	}
+	m.Output = func(v int) {
+		outputs = append(outputs, v)
+	}
+	if err := m.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []int{3, 1, 2}; !reflect.DeepEqual(outputs, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, outputs)
+	}
+	if !m.Halted() || m.PC != 2 {
+		t.Errorf("expected the machine to halt at pc 2, got pc %d\n", m.PC)
+	}
+
+	m.Reset()
+	inputs = nil
+	if err := m.Run(); !errors.Is(err, ErrNoInput) {
+		t.Errorf("expected: %v, actual: %v\n", ErrNoInput, err)
+	}
+}
+
+func TestMachineRunUntil(t *testing.T) {
+	// 2020/08 sample which runs into an infinite loop.
+	handheld := InstructionSet{
+		"nop": {Arity: 1, Exec: func(m *Machine, args []string) error { return nil }},
+		"acc": {Arity: 1, Exec: func(m *Machine, args []string) error {
+			m.Registers["acc"] += m.Value(args[0])
+			return nil
+		}},
+		"jmp": {Arity: 1, Exec: func(m *Machine, args []string) error {
+			m.Jump(m.Value(args[0]))
+			return nil
+		}},
+	}
+	m := New(Parse([]string{
+		"nop +0", "acc +1", "jmp +4", "acc +3", "jmp -3",
+		"acc -99", "acc +1", "jmp -4", "acc +6",
+	}), handheld)
+
+	seen := make(map[int]bool)
+	stopped, err := m.RunUntil(func(m *Machine) bool {
+		if seen[m.PC] {
+			return true
+		}
+		seen[m.PC] = true
+		return false
+	})
+	if err != nil || !stopped || m.Registers["acc"] != 5 {
+		t.Errorf("expected the loop with acc = 5, got acc = %d (%t, %v)\n", m.Registers["acc"], stopped, err)
+	}
+}
+
+func TestMachineSnapshot(t *testing.T) {
+	m := New(Parse([]string{"inc a", "inc b", "inc a"}), assembunny)
+	m.Step()
+	s := m.Snapshot()
+	m.Program[2].Op = "dec"
+	m.Run()
+	if m.Registers["a"] != 0 || m.Registers["b"] != 1 {
+		t.Errorf("unexpected registers: %v\n", m.Registers)
+	}
+
+	m.Restore(s)
+	m.Run()
+	if m.Registers["a"] != 2 || m.Registers["b"] != 1 || m.Steps != 3 {
+		t.Errorf("unexpected registers after restore: %v\n", m.Registers)
+	}
+}
+
+func TestMachineErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		line string
+		err  error
+	}{
+		{name: "unknown", line: "mul a 2", err: ErrUnknownOp},
+		{name: "arity", line: "inc a b", err: ErrArity},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := New(Parse([]string{tc.line}), assembunny)
+			if err := m.Run(); !errors.Is(err, tc.err) {
+				t.Errorf("expected: %v, actual: %v\n", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	expected := []Instruction{
+		{Op: "jio", Args: []string{"a", "+19"}},
+		{Op: "hlf", Args: []string{"a"}},
+	}
+	if actual := Parse([]string{"jio a, +19", "", "hlf a"}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
@@ -1,10 +1,6 @@
 package util
 
-import (
-	"strconv"
-
-	"golang.org/x/exp/constraints"
-)
+import "golang.org/x/exp/constraints"
 
 // Sum is used to add all the integers in a given array.
 func Sum[T constraints.Integer | constraints.Float | constraints.Complex](arr []T) T {
@@ -36,6 +32,42 @@ func Min[T constraints.Integer | constraints.Float](x, y T) T {
 	return y
 }
 
+// Product is used to multiply all the numbers in a given array. The product
+// of an empty array is 1.
+func Product[T constraints.Integer | constraints.Float | constraints.Complex](arr []T) T {
+	total := T(1)
+	for _, n := range arr {
+		total *= n
+	}
+	return total
+}
+
+// MaxOf returns the largest of the given values. A slice can be passed as
+// MaxOf(sl...). This will panic if there are no values.
+func MaxOf[T constraints.Integer | constraints.Float](vals ...T) T {
+	if len(vals) == 0 {
+		panic("util.MaxOf: no values")
+	}
+	result := vals[0]
+	for _, v := range vals[1:] {
+		result = Max(result, v)
+	}
+	return result
+}
+
+// MinOf returns the smallest of the given values. A slice can be passed as
+// MinOf(sl...). This will panic if there are no values.
+func MinOf[T constraints.Integer | constraints.Float](vals ...T) T {
+	if len(vals) == 0 {
+		panic("util.MinOf: no values")
+	}
+	result := vals[0]
+	for _, v := range vals[1:] {
+		result = Min(result, v)
+	}
+	return result
+}
+
 // Abs returns an absolute value of the given integer or floating-point number.
 func Abs[T constraints.Integer | constraints.Float](n T) T {
 	if n < 0 {
@@ -44,13 +76,35 @@ func Abs[T constraints.Integer | constraints.Float](n T) T {
 	return n
 }
 
-// Mod returns a % b, specifically the least positive remainder. This is
-// different than the builtin % operator which returns the least negative
-// remainder. This should only be used if either a or b is negative. Mod
-// behaves the same as the builtin % operator when both a and b are positive.
+// Mod returns a % b, specifically the least non-negative remainder, i.e., the
+// result is always in the range [0, |b|). This is different than the builtin
+// % operator which returns a negative remainder for a negative a. Mod behaves
+// the same as the builtin % operator when both a and b are non-negative.
 func Mod[T constraints.Integer](a, b T) T {
-	// https://stackoverflow.com/q/43018206
-	return ((a % b) + b) % b
+	m := a % b
+	if m < 0 {
+		if b < 0 {
+			m -= b
+		} else {
+			m += b
+		}
+	}
+	return m
+}
+
+// DivMod returns the quotient and the remainder of a divided by b such that
+// a == q*b + r where the remainder r is the same as Mod(a, b). This is the
+// euclidean division, so the quotient is rounded down for a positive b, unlike
+// the builtin / operator which truncates toward zero.
+func DivMod[T constraints.Signed](a, b T) (q, r T) {
+	r = Mod(a, b)
+	return (a - r) / b, r
+}
+
+// Clamp returns v limited to the range [lo, hi]. The result is undefined if
+// lo is greater than hi.
+func Clamp[T constraints.Integer | constraints.Float](v, lo, hi T) T {
+	return Min(Max(v, lo), hi)
 }
 
 // Digits is used to iterate over each digit of the given number from left to
@@ -67,17 +121,58 @@ func Mod[T constraints.Integer](a, b T) T {
 //	// ... other code
 //	fmt.Println(<-ch)
 func Digits(n int) <-chan int {
-	ns := strconv.Itoa(n)
-	ch := make(chan int, len(ns))
-	go func() {
-		for _, d := range ns {
-			ch <- int(d - '0')
-		}
-		close(ch)
-	}()
+	digits := DigitsSlice(n)
+	// The channel is buffered to hold all the digits, so it can be filled
+	// right away without a goroutine.
+	ch := make(chan int, len(digits))
+	for _, d := range digits {
+		ch <- d
+	}
+	close(ch)
 	return ch
 }
 
+// DigitsSlice returns the decimal digits of the given number from left to
+// right. The sign of a negative number is ignored.
+func DigitsSlice(n int) []int {
+	digits := make([]int, NumDigits(n))
+	u := absUint(n)
+	for i := len(digits) - 1; i >= 0; i-- {
+		digits[i] = int(u % 10)
+		u /= 10
+	}
+	return digits
+}
+
+// FromDigits returns the number formed by the given decimal digits from left
+// to right. This is the inverse of DigitsSlice for a non-negative number.
+func FromDigits(digits []int) int {
+	n := 0
+	for _, d := range digits {
+		n = n*10 + d
+	}
+	return n
+}
+
+// NumDigits returns the number of decimal digits in the given number, which
+// is 1 for zero. The sign of a negative number is ignored.
+func NumDigits(n int) int {
+	count := 1
+	for u := absUint(n); u >= 10; u /= 10 {
+		count++
+	}
+	return count
+}
+
+// absUint returns the absolute value of n as an unsigned integer, which
+// doesn't overflow for the minimum int.
+func absUint(n int) uint {
+	if n < 0 {
+		return -uint(n)
+	}
+	return uint(n)
+}
+
 // Returns a number representing sign of n.
 //
 //   - 0 if the number is zero
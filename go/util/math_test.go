@@ -0,0 +1,127 @@
+package util
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAggregate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		vals     []int
+		product  int
+		min, max int
+	}{
+		{name: "single", vals: []int{-4}, product: -4, min: -4, max: -4},
+		{name: "many", vals: []int{3, -1, 4, 1, 5}, product: -60, min: -1, max: 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if p := Product(tc.vals); p != tc.product {
+				t.Errorf("Product(%v); expected: %d, actual: %d\n", tc.vals, tc.product, p)
+			}
+			if m := MinOf(tc.vals...); m != tc.min {
+				t.Errorf("MinOf(%v); expected: %d, actual: %d\n", tc.vals, tc.min, m)
+			}
+			if m := MaxOf(tc.vals...); m != tc.max {
+				t.Errorf("MaxOf(%v); expected: %d, actual: %d\n", tc.vals, tc.max, m)
+			}
+		})
+	}
+
+	if p := Product([]float64{}); p != 1 {
+		t.Errorf("Product([]); expected: 1, actual: %v\n", p)
+	}
+	if m := MaxOf(1.5, 2.5); m != 2.5 {
+		t.Errorf("MaxOf(1.5, 2.5); expected: 2.5, actual: %v\n", m)
+	}
+}
+
+func TestDigits(t *testing.T) {
+	testCases := []struct {
+		name     string
+		n        int
+		expected []int
+	}{
+		{name: "zero", n: 0, expected: []int{0}},
+		{name: "single", n: 7, expected: []int{7}},
+		{name: "many", n: 13579, expected: []int{1, 3, 5, 7, 9}},
+		{name: "trailing zeros", n: 1200, expected: []int{1, 2, 0, 0}},
+		{name: "negative", n: -42, expected: []int{4, 2}},
+		{name: "min int", n: math.MinInt64, expected: []int{9, 2, 2, 3, 3, 7, 2, 0, 3, 6, 8, 5, 4, 7, 7, 5, 8, 0, 8}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := DigitsSlice(tc.n); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("DigitsSlice(%d)\nexpected: %v\nactual: %v\n", tc.n, tc.expected, actual)
+			}
+			if n := NumDigits(tc.n); n != len(tc.expected) {
+				t.Errorf("NumDigits(%d); expected: %d, actual: %d\n", tc.n, len(tc.expected), n)
+			}
+			var fromChan []int
+			for d := range Digits(tc.n) {
+				fromChan = append(fromChan, d)
+			}
+			if !reflect.DeepEqual(fromChan, tc.expected) {
+				t.Errorf("Digits(%d)\nexpected: %v\nactual: %v\n", tc.n, tc.expected, fromChan)
+			}
+			if tc.n >= 0 {
+				if n := FromDigits(tc.expected); n != tc.n {
+					t.Errorf("FromDigits(%v); expected: %d, actual: %d\n", tc.expected, tc.n, n)
+				}
+			}
+		})
+	}
+}
+
+func TestModAndDivMod(t *testing.T) {
+	testCases := []struct {
+		name string
+		a, b int
+		q, r int
+	}{
+		{name: "positive", a: 7, b: 3, q: 2, r: 1},
+		{name: "negative dividend", a: -7, b: 3, q: -3, r: 2},
+		{name: "negative divisor", a: 7, b: -3, q: -2, r: 1},
+		{name: "both negative", a: -7, b: -3, q: 3, r: 2},
+		{name: "exact", a: -9, b: 3, q: -3, r: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if r := Mod(tc.a, tc.b); r != tc.r {
+				t.Errorf("Mod(%d, %d); expected: %d, actual: %d\n", tc.a, tc.b, tc.r, r)
+			}
+			if q, r := DivMod(tc.a, tc.b); q != tc.q || r != tc.r {
+				t.Errorf("DivMod(%d, %d); expected: (%d, %d), actual: (%d, %d)\n", tc.a, tc.b, tc.q, tc.r, q, r)
+			}
+		})
+	}
+
+	if r := Mod(uint8(250), 7); r != 5 {
+		t.Errorf("Mod(250, 7); expected: 5, actual: %d\n", r)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	testCases := []struct {
+		name      string
+		v, lo, hi int
+		expected  int
+	}{
+		{name: "below", v: -5, lo: 0, hi: 10, expected: 0},
+		{name: "inside", v: 5, lo: 0, hi: 10, expected: 5},
+		{name: "above", v: 15, lo: 0, hi: 10, expected: 10},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := Clamp(tc.v, tc.lo, tc.hi); actual != tc.expected {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -1,48 +1,79 @@
 package util
 
-var cardinalDirection = [][]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+import (
+	"iter"
+	"slices"
+)
 
-// CardinalDirection returns a list of coordinates in the four cardinal
-// directions from the given point (x, y).
+var cardinalDirection = [4][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+
+// CardinalNeighbors returns an iterator over the coordinates (row, col) in the
+// four cardinal directions from the given point (x, y). Unlike the function
+// CardinalDirection, this does not allocate, so it's suitable for the inner
+// loop of a search over a grid.
 //
-// The list contains only the coordinates which are within the matrix bounds as
-// given by the rows and cols argument. The four cardinal directions are:
-// North, South, East and West.
-func CardinalDirection(y, x, rows, cols int) [][]int {
-	// Initialize the slice with a capacity of 4 (possible directions).
-	pos := make([][]int, 0, 4)
-	for _, d := range cardinalDirection {
-		// y -> row
-		// x -> column
-		r, c := y+d[1], x+d[0]
-		if r < 0 || c < 0 || r >= rows || c >= cols {
-			continue
+// Only the coordinates which are within the matrix bounds as given by the
+// rows and cols argument are yielded. The four cardinal directions are:
+// North, East, South and West.
+func CardinalNeighbors(y, x, rows, cols int) iter.Seq[[2]int] {
+	return func(yield func([2]int) bool) {
+		for _, d := range cardinalDirection {
+			// y -> row
+			// x -> column
+			r, c := y+d[1], x+d[0]
+			if r < 0 || c < 0 || r >= rows || c >= cols {
+				continue
+			}
+			if !yield([2]int{r, c}) {
+				return
+			}
 		}
-		pos = append(pos, []int{r, c})
 	}
-	return pos
 }
 
-// AllDirection is similar to CardinalDirection except this returns coordinates
-// in all the directions from a given point, excluding the given point.
-func AllDirection(y, x, rows, cols int) [][2]int {
-	pos := make([][2]int, 0, 9)
-	for dy := -1; dy <= 1; dy++ {
-		r := y + dy
-		if r < 0 || r >= rows {
-			continue
-		}
-		for dx := -1; dx <= 1; dx++ {
-			c := x + dx
-			if (dy == 0 && dx == 0) || (c < 0 || c >= cols) {
+// AllNeighbors is similar to CardinalNeighbors except this yields the
+// coordinates in all the directions from a given point, excluding the given
+// point, in row major order.
+func AllNeighbors(y, x, rows, cols int) iter.Seq[[2]int] {
+	return func(yield func([2]int) bool) {
+		for dy := -1; dy <= 1; dy++ {
+			r := y + dy
+			if r < 0 || r >= rows {
 				continue
 			}
-			pos = append(pos, [2]int{r, c})
+			for dx := -1; dx <= 1; dx++ {
+				c := x + dx
+				if (dy == 0 && dx == 0) || (c < 0 || c >= cols) {
+					continue
+				}
+				if !yield([2]int{r, c}) {
+					return
+				}
+			}
 		}
 	}
+}
+
+// CardinalDirection returns a list of coordinates in the four cardinal
+// directions from the given point (x, y). This is the same as collecting
+// CardinalNeighbors, which should be preferred in the inner loops as it
+// doesn't allocate.
+func CardinalDirection(y, x, rows, cols int) [][]int {
+	// Initialize the slice with a capacity of 4 (possible directions).
+	pos := make([][]int, 0, 4)
+	for p := range CardinalNeighbors(y, x, rows, cols) {
+		pos = append(pos, []int{p[0], p[1]})
+	}
 	return pos
 }
 
+// AllDirection is similar to CardinalDirection except this returns coordinates
+// in all the directions from a given point, excluding the given point. This
+// is the same as collecting AllNeighbors.
+func AllDirection(y, x, rows, cols int) [][2]int {
+	return slices.AppendSeq(make([][2]int, 0, 8), AllNeighbors(y, x, rows, cols))
+}
+
 // MatrixCopy is used to copy integer slice elements from source slice to a
 // destination slice. Internally, this uses the built-in copy function to copy
 // individual slice elements.
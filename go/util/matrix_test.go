@@ -135,3 +135,73 @@ func TestCardinalDirection(t *testing.T) {
 		})
 	}
 }
+
+func TestNeighbors(t *testing.T) {
+	testCases := []struct {
+		name             string
+		y, x, rows, cols int
+		cardinal         [][2]int
+		all              [][2]int
+	}{
+		{
+			name:     "corner",
+			y:        0,
+			x:        0,
+			rows:     3,
+			cols:     3,
+			cardinal: [][2]int{{0, 1}, {1, 0}},
+			all:      [][2]int{{0, 1}, {1, 0}, {1, 1}},
+		},
+		{
+			name:     "center",
+			y:        1,
+			x:        1,
+			rows:     3,
+			cols:     3,
+			cardinal: [][2]int{{0, 1}, {1, 2}, {2, 1}, {1, 0}},
+			all:      [][2]int{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 2}, {2, 0}, {2, 1}, {2, 2}},
+		},
+		{
+			name:     "single",
+			y:        0,
+			x:        0,
+			rows:     1,
+			cols:     1,
+			cardinal: nil,
+			all:      nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cardinal, all [][2]int
+			for p := range CardinalNeighbors(tc.y, tc.x, tc.rows, tc.cols) {
+				cardinal = append(cardinal, p)
+			}
+			for p := range AllNeighbors(tc.y, tc.x, tc.rows, tc.cols) {
+				all = append(all, p)
+			}
+			if !reflect.DeepEqual(cardinal, tc.cardinal) {
+				t.Errorf("CardinalNeighbors\nexpected: %v\nactual: %v\n", tc.cardinal, cardinal)
+			}
+			if !reflect.DeepEqual(all, tc.all) {
+				t.Errorf("AllNeighbors\nexpected: %v\nactual: %v\n", tc.all, all)
+			}
+		})
+	}
+}
+
+func TestNeighborsAllocs(t *testing.T) {
+	count := 0
+	allocs := testing.AllocsPerRun(100, func() {
+		for p := range AllNeighbors(1, 1, 3, 3) {
+			count += p[0]
+		}
+		for p := range CardinalNeighbors(1, 1, 3, 3) {
+			count += p[1]
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("expected no allocations, actual: %v\n", allocs)
+	}
+}
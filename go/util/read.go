@@ -1,6 +1,9 @@
 package util
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 // ReadLines is used to read the content of the file at a given path into a
 // string slice where each element corresponds to a single line.
@@ -16,14 +19,7 @@ func ReadLines(input string) []string {
 // ReadLinesAsInt is similar to ReadLines, except this will convert each line
 // into an integer and return an int slice instead.
 func ReadLinesAsInt(input string) []int {
-	lines := ReadLines(input)
-
-	ints := make([]int, len(lines))
-	for i, line := range lines {
-		ints[i] = MustAtoi(line)
-	}
-
-	return ints
+	return MustParse(ReadLines(input), strconv.Atoi)
 }
 
 // ReadSections is used to read the content of the file at a given path by
@@ -44,3 +40,63 @@ func ReadSections(input string) [][]string {
 
 	return sections
 }
+
+// ReadInts is used to read the content containing a single integer per line
+// into an int slice. Unlike ReadLinesAsInt, the whitespace around the
+// integers is ignored along with the blank lines.
+func ReadInts(input string) []int {
+	var ints []int
+	for i, line := range strings.Split(input, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			n, err := AtoiAt(line, i)
+			if err != nil {
+				panic("util.ReadInts: " + err.Error())
+			}
+			ints = append(ints, n)
+		}
+	}
+
+	return ints
+}
+
+// ReadCommaInts is used to read the content containing a comma-separated list
+// of integers, like "3,4,3,1,2", into an int slice. The whitespace around
+// the integers is ignored.
+func ReadCommaInts(input string) []int {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil
+	}
+
+	fields := strings.Split(input, ",")
+	ints := make([]int, len(fields))
+	for i, field := range fields {
+		ints[i] = MustAtoi(strings.TrimSpace(field))
+	}
+
+	return ints
+}
+
+// ReadIntGrid is used to read the content containing whitespace-separated
+// integers on each line into a two dimensional int slice, where each element
+// corresponds to the integers of a single line. The blank lines are skipped.
+func ReadIntGrid(input string) [][]int {
+	var grid [][]int
+	for i, line := range strings.Split(input, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		row := make([]int, len(fields))
+		for j, field := range fields {
+			n, err := strconv.Atoi(field)
+			if err != nil {
+				panic("util.ReadIntGrid: " + (&LineError{Line: i + 1, Content: line, Err: err}).Error())
+			}
+			row[j] = n
+		}
+		grid = append(grid, row)
+	}
+
+	return grid
+}
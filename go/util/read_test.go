@@ -0,0 +1,40 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadInts(t *testing.T) {
+	expected := []int{199, -200, 208}
+	if actual := ReadInts("199\n -200 \n\n208\n"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
+
+func TestReadCommaInts(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []int
+	}{
+		{name: "simple", input: "3,4,3,1,2", expected: []int{3, 4, 3, 1, 2}},
+		{name: "whitespace", input: " 16, -1 ,2\n", expected: []int{16, -1, 2}},
+		{name: "empty", input: "\n", expected: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := ReadCommaInts(tc.input); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestReadIntGrid(t *testing.T) {
+	expected := [][]int{{22, 13, 17}, {8, 2, 23}, {-1}}
+	if actual := ReadIntGrid("22 13 17\n 8  2 23\n\n-1\n"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
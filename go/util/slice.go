@@ -20,3 +20,40 @@ func MinMax(sl []int) (int, int) {
 	}
 	return min, max
 }
+
+// Map returns a new slice with the function f applied to every element of
+// the given slice, in order.
+func Map[T, U any](sl []T, f func(T) U) []U {
+	result := make([]U, len(sl))
+	for i, v := range sl {
+		result[i] = f(v)
+	}
+	return result
+}
+
+// Filter returns a new slice with only the elements of the given slice for
+// which pred returns true, in order.
+func Filter[T any](sl []T, pred func(T) bool) []T {
+	var result []T
+	for _, v := range sl {
+		if pred(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Unique returns a new slice with the duplicate elements of the given slice
+// removed, keeping the first occurrence of every element in order. Unlike
+// slices.Compact, the duplicates need not be adjacent.
+func Unique[T comparable](sl []T) []T {
+	seen := make(map[T]struct{}, len(sl))
+	var result []T
+	for _, v := range sl {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			result = append(result, v)
+		}
+	}
+	return result
+}
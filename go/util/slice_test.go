@@ -0,0 +1,44 @@
+package util
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	expected := []string{"1", "-2", "3"}
+	if actual := Map([]int{1, -2, 3}, strconv.Itoa); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+	if actual := Map([]int{}, strconv.Itoa); len(actual) != 0 {
+		t.Errorf("expected an empty slice, actual: %v\n", actual)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	testCases := []struct {
+		name     string
+		sl       []int
+		expected []int
+	}{
+		{name: "some", sl: []int{1, 2, 3, 4, 6}, expected: []int{2, 4, 6}},
+		{name: "none", sl: []int{1, 3}, expected: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := Filter(tc.sl, even); !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("\nexpected: %v\nactual: %v\n", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestUnique(t *testing.T) {
+	expected := []string{"b", "a", "c"}
+	if actual := Unique([]string{"b", "a", "b", "c", "a"}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, actual)
+	}
+}
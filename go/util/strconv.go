@@ -1,6 +1,7 @@
 package util
 
 import (
+	"fmt"
 	"strconv"
 )
 
@@ -26,3 +27,56 @@ func MustParseInt(s string, base int, bitSize int) int64 {
 	}
 	return i
 }
+
+// LineError records a failure to parse a line of the input along with the
+// line number and its content.
+type LineError struct {
+	// Line is the line number, starting from 1.
+	Line    int
+	Content string
+	Err     error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d %q: %v", e.Line, e.Content, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// AtoiAt is like strconv.Atoi for the line at the given index, starting from
+// 0, of the input. The error, if any, is a *LineError which includes the line
+// number and the content of the line.
+func AtoiAt(line string, idx int) (int, error) {
+	n, err := strconv.Atoi(line)
+	if err != nil {
+		return 0, &LineError{Line: idx + 1, Content: line, Err: err}
+	}
+	return n, nil
+}
+
+// ParseLines converts every line using the parse function and returns the
+// converted values in order. It stops at the first failure and returns the
+// error as a *LineError which includes the line number and the content of the
+// offending line.
+func ParseLines[T any](lines []string, parse func(line string) (T, error)) ([]T, error) {
+	values := make([]T, len(lines))
+	for i, line := range lines {
+		v, err := parse(line)
+		if err != nil {
+			return nil, &LineError{Line: i + 1, Content: line, Err: err}
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+// MustParse is like ParseLines but panics if the parsing fails.
+func MustParse[T any](lines []string, parse func(line string) (T, error)) []T {
+	values, err := ParseLines(lines, parse)
+	if err != nil {
+		panic("util.MustParse: " + err.Error())
+	}
+	return values
+}
@@ -0,0 +1,50 @@
+package util
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestAtoiAt(t *testing.T) {
+	if n, err := AtoiAt("-42", 0); err != nil || n != -42 {
+		t.Errorf("expected: -42 (nil), actual: %d (%v)\n", n, err)
+	}
+
+	_, err := AtoiAt("4x2", 6)
+	var lineErr *LineError
+	if !errors.As(err, &lineErr) || lineErr.Line != 7 || lineErr.Content != "4x2" {
+		t.Fatalf("expected a line error for line 7, actual: %v\n", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected the error to wrap strconv.ErrSyntax, actual: %v\n", err)
+	}
+	if expected := `line 7 "4x2": strconv.Atoi: parsing "4x2": invalid syntax`; err.Error() != expected {
+		t.Errorf("\nexpected: %v\nactual: %v\n", expected, err)
+	}
+}
+
+func TestParseLines(t *testing.T) {
+	values, err := ParseLines([]string{"1", "2", "3"}, strconv.Atoi)
+	if expected := []int{1, 2, 3}; err != nil || !reflect.DeepEqual(values, expected) {
+		t.Errorf("\nexpected: %v (nil)\nactual: %v (%v)\n", expected, values, err)
+	}
+
+	_, err = ParseLines([]string{"1", "two", "3"}, strconv.Atoi)
+	var lineErr *LineError
+	if !errors.As(err, &lineErr) || lineErr.Line != 2 || lineErr.Content != "two" {
+		t.Errorf("expected a line error for line 2, actual: %v\n", err)
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	defer func() {
+		r := recover()
+		if msg, ok := r.(string); !ok || !strings.Contains(msg, `line 3 "x"`) {
+			t.Errorf("expected a panic with the line context, actual: %v\n", r)
+		}
+	}()
+	ReadLinesAsInt("1\n2\nx")
+}
@@ -2,13 +2,67 @@ package util
 
 import (
 	"sort"
+	"strings"
 )
 
 // SortString is used to sort the individual characters in the given string.
+//
+// An ASCII string is sorted using a counting sort in linear time, which is the
+// common case for the puzzle inputs. Any other string is sorted by its runes.
 func SortString(s string) string {
+	var counts [128]int
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 128 {
+			return sortRunes(s)
+		}
+		counts[s[i]]++
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for c, count := range counts {
+		for ; count > 0; count-- {
+			sb.WriteByte(byte(c))
+		}
+	}
+	return sb.String()
+}
+
+// sortRunes is used to sort the individual runes in the given string.
+func sortRunes(s string) string {
 	ss := []rune(s)
 	sort.Slice(ss, func(i, j int) bool {
 		return ss[i] < ss[j]
 	})
 	return string(ss)
 }
+
+// ReverseString returns the given string with its characters in the reverse
+// order.
+func ReverseString(s string) string {
+	rs := []rune(s)
+	Reverse(rs)
+	return string(rs)
+}
+
+// RotateString returns the given string with its characters rotated to the
+// right by n positions, such that the last n characters move to the front.
+// A negative n rotates to the left instead. For example, rotating "abcd" by 1
+// gives "dabc" and by -1 gives "bcda".
+func RotateString(s string, n int) string {
+	rs := []rune(s)
+	if len(rs) == 0 {
+		return s
+	}
+	n = Mod(n, len(rs))
+	return string(rs[len(rs)-n:]) + string(rs[:len(rs)-n])
+}
+
+// SwapAt returns the given string with the characters at the positions i and
+// j swapped. The positions are in terms of the characters and not the bytes.
+// It panics if either of the positions are out of range.
+func SwapAt(s string, i, j int) string {
+	rs := []rune(s)
+	rs[i], rs[j] = rs[j], rs[i]
+	return string(rs)
+}
@@ -0,0 +1,31 @@
+package util
+
+import "testing"
+
+func TestStringHelpers(t *testing.T) {
+	testCases := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{name: "sort ascii", actual: SortString("cfbegad"), expected: "abcdefg"},
+		{name: "sort duplicates", actual: SortString("banana"), expected: "aaabnn"},
+		{name: "sort unicode", actual: SortString("γβα"), expected: "αβγ"},
+		{name: "sort empty", actual: SortString(""), expected: ""},
+		{name: "reverse", actual: ReverseString("abcde"), expected: "edcba"},
+		{name: "reverse unicode", actual: ReverseString("aβc"), expected: "cβa"},
+		{name: "rotate right", actual: RotateString("abcde", 1), expected: "eabcd"},
+		{name: "rotate left", actual: RotateString("abcde", -1), expected: "bcdea"},
+		{name: "rotate around", actual: RotateString("abcde", 7), expected: "deabc"},
+		{name: "rotate empty", actual: RotateString("", 3), expected: ""},
+		{name: "swap", actual: SwapAt("abcde", 4, 0), expected: "ebcda"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.actual != tc.expected {
+				t.Errorf("\nexpected: %q\nactual: %q\n", tc.expected, tc.actual)
+			}
+		})
+	}
+}
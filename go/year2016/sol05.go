@@ -1,33 +1,34 @@
 package year2016
 
 import (
-	"crypto/md5"
-	"encoding/hex"
 	"fmt"
+
+	"github.com/dhruvmanila/advent-of-code/go/pkg/hashcrack"
 )
 
 const input = "cxdnnyjw"
 
-func Sol05(_ string) (string, error) {
+// findPasswords returns the passwords for both the parts for the given door ID.
+func findPasswords(doorID string) (string, string) {
 	password1 := make([]byte, 0, 8)
 	passwordLetters := make(map[int]byte, 8)
-	for i := 0; len(password1) != 8 || len(passwordLetters) != 8; i++ {
-		sum := md5.Sum([]byte(fmt.Sprintf("%s%d", input, i)))
-		if sum[0] == 0 && sum[1] == 0 && sum[2]&0xF0 == 0 {
-			hexStr := hex.EncodeToString(sum[:])
-			if len(password1) != 8 {
-				password1 = append(password1, hexStr[5])
-			}
-			if len(passwordLetters) != 8 {
-				position := int(hexStr[5] - '0')
-				switch position {
-				case 0, 1, 2, 3, 4, 5, 6, 7:
-					if _, ok := passwordLetters[position]; !ok {
-						passwordLetters[position] = hexStr[6]
-					}
+	for m := range hashcrack.Search(doorID, hashcrack.LeadingZeros(5)) {
+		hexStr := m.Hex()
+		if len(password1) != 8 {
+			password1 = append(password1, hexStr[5])
+		}
+		if len(passwordLetters) != 8 {
+			position := int(hexStr[5] - '0')
+			switch position {
+			case 0, 1, 2, 3, 4, 5, 6, 7:
+				if _, ok := passwordLetters[position]; !ok {
+					passwordLetters[position] = hexStr[6]
 				}
 			}
 		}
+		if len(password1) == 8 && len(passwordLetters) == 8 {
+			break
+		}
 	}
 
 	password2 := make([]byte, 8)
@@ -35,5 +36,10 @@ func Sol05(_ string) (string, error) {
 		password2[position] = letter
 	}
 
-	return fmt.Sprintf("5.1: %s\n5.2: %s\n", string(password1), string(password2)), nil
+	return string(password1), string(password2)
+}
+
+func Sol05(_ string) (string, error) {
+	password1, password2 := findPasswords(input)
+	return fmt.Sprintf("5.1: %s\n5.2: %s\n", password1, password2), nil
 }
@@ -13,7 +13,7 @@ func Sol06(input string) (string, error) {
 	// size is the length of the message.
 	size := len(lines[0])
 
-	counters := make([]counter.Counter[rune], 0, size)
+	counters := make([]*counter.Counter[rune], 0, size)
 	for i := 0; i < size; i++ {
 		counters = append(counters, counter.New[rune]())
 	}
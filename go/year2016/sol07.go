@@ -98,10 +98,7 @@ func hasABA(s string) bool {
 func Sol07(input string) (string, error) {
 	lines := util.ReadLines(input)
 
-	ipAddresses := make([]*ipAddress, 0, len(lines))
-	for _, line := range lines {
-		ipAddresses = append(ipAddresses, newIpAddressFromLine(line))
-	}
+	ipAddresses := util.Map(lines, newIpAddressFromLine)
 
 	tlsCount := 0
 	sslCount := 0
@@ -45,7 +45,7 @@ func newSeatLayout(grid [][]byte) *seatLayout {
 // to the given seat for part 1.
 func (sl *seatLayout) occupiedAroundV1(row, col int) int {
 	count := 0
-	for _, pos := range util.AllDirection(row, col, sl.rows, sl.cols) {
+	for pos := range util.AllNeighbors(row, col, sl.rows, sl.cols) {
 		if sl.grid[pos[0]][pos[1]] == occupied {
 			count++
 		}
@@ -5,6 +5,7 @@ import (
 	"math"
 	"strings"
 
+	"github.com/dhruvmanila/advent-of-code/go/pkg/mathutil"
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
 
@@ -21,15 +22,18 @@ func earliestDeparture(earliest int, buses [][2]int) (earliestBus, wait int) {
 	return earliestBus, wait
 }
 
+// earliestTimestamp returns the earliest timestamp t such that every bus
+// departs at its offset from t. This is the solution to the system of
+// congruences t ≡ -offset (mod id) using the Chinese Remainder Theorem.
 func earliestTimestamp(buses [][2]int) int {
-	t := 0
-	offset := 1
-	for _, bus := range buses {
-		bId, idx := bus[0], bus[1]
-		for ((t + idx) % bId) != 0 {
-			t += offset
-		}
-		offset *= bId
+	remainders := make([]int, len(buses))
+	moduli := make([]int, len(buses))
+	for i, bus := range buses {
+		remainders[i], moduli[i] = -bus[1], bus[0]
+	}
+	t, _, ok := mathutil.CRT(remainders, moduli)
+	if !ok {
+		panic("no timestamp matches the bus schedule")
 	}
 	return t
 }
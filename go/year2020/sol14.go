@@ -81,8 +81,7 @@ func runV2(program []string) int {
 			addr, val := util.MustAtoi(matches[2]), util.MustAtoi(matches[3])
 			// The initial possible address where all the Xs are zero.
 			addr = (addr & clearMask) | setMask
-			mem[addr] = val
-			for _, comb := range combinations.All(floatingBits) {
+			for comb := range combinations.PowerSet(floatingBits) {
 				nextAddr := addr
 				for _, c := range comb {
 					nextAddr |= c
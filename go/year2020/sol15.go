@@ -2,7 +2,6 @@ package year2020
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
@@ -26,12 +25,7 @@ func play(numbers []int, rounds int) int {
 }
 
 func Sol15(input string) (string, error) {
-	lines := util.ReadLines(input)
-
-	var numbers []int
-	for _, s := range strings.Split(lines[0], ",") {
-		numbers = append(numbers, util.MustAtoi(s))
-	}
+	numbers := util.ReadCommaInts(input)
 
 	return fmt.Sprintf("15.1: %d\n15.2: %d\n", play(numbers, 2020), play(numbers, 30000000)), nil
 }
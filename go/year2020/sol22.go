@@ -10,17 +10,13 @@ import (
 
 type player struct {
 	id   uint8
-	deck queue.Queue[int]
+	deck *queue.Queue[int]
 }
 
 func newPlayer(playerId uint8, cards []int) *player {
-	deck := queue.New[int]()
-	for _, card := range cards {
-		deck.Enqueue(card)
-	}
 	return &player{
 		id:   playerId,
-		deck: *deck,
+		deck: queue.New(cards...),
 	}
 }
 
@@ -67,13 +63,10 @@ func playRecursiveCombat(p1, p2 *player) *player {
 			if p1.deck.Len() < c1 || p2.deck.Len() < c2 {
 				p1wins = c1 > c2
 			} else {
-				d1, d2 := make(queue.Queue[int], c1), make(queue.Queue[int], c2)
-				copy(d1, p1.deck[:c1])
-				copy(d2, p2.deck[:c2])
 				// Recursive call for the sub-game
 				p1wins = play(
-					&player{id: p1.id, deck: d1},
-					&player{id: p2.id, deck: d2},
+					&player{id: p1.id, deck: p1.deck.Clone(c1)},
+					&player{id: p2.id, deck: p2.deck.Clone(c2)},
 				).id == p1.id
 			}
 			if p1wins {
@@ -129,12 +129,9 @@ func parseBoards(lines []string) ([]*board, error) {
 func Sol04(input string) (string, error) {
 	lines := util.ReadLines(input)
 
-	var draws []int
 	// Collect all the numbers which are to be drawn. This is the first line
 	// of the input and is a comma-separated list of numbers.
-	for _, s := range strings.Split(lines[0], ",") {
-		draws = append(draws, util.MustAtoi(s))
-	}
+	draws := util.ReadCommaInts(lines[0])
 
 	boards, err := parseBoards(lines[2:])
 	if err != nil {
@@ -2,47 +2,45 @@ package year2021
 
 import (
 	"fmt"
-	"strings"
 
+	"github.com/dhruvmanila/advent-of-code/go/pkg/matrix"
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
 
+// lanternfishTransition is the matrix which maps the number of fishes for
+// every timer value on one day to the next day. The timer for every fish is
+// decreased by one except for the fishes with the timer 0, which are reset to
+// 6 and each give birth to a new fish with the timer 8.
+var lanternfishTransition = func() *matrix.Dense[int] {
+	m := matrix.NewDense[int](9, 9, nil)
+	for timer := 1; timer < 9; timer++ {
+		m.Set(timer-1, timer, 1)
+	}
+	m.Set(6, 0, 1)
+	m.Set(8, 0, 1)
+	return m
+}()
+
 func simulate(fishes []int, days int) int {
-	// fishCount is an array containing the number of fishes corresponding to
-	// the index which represents the number of days remaining until creating a
-	// new fish.
-	var fishCount [9]int
+	// fishCount is a column vector containing the number of fishes
+	// corresponding to the index which represents the number of days
+	// remaining until creating a new fish.
+	fishCount := matrix.NewDense[int](9, 1, nil)
 
 	// Start by adding the given fish to the counter.
 	for _, f := range fishes {
-		fishCount[f]++
-	}
-
-	for d := 0; d < days; d++ {
-		newFishes := fishCount[0]
-		// Shift all the elements in the array one position down which behaves
-		// as the timer being decreased for every fish.
-		for cycleDay, count := range fishCount[1:] {
-			fishCount[cycleDay] = count
-		}
-		// Reset the cycle for all the fishes ready to give birth.
-		fishCount[6] += newFishes
-		// The cycle for all the new fishes is 8.
-		fishCount[8] = newFishes
+		fishCount.Set(f, 0, fishCount.At(f, 0)+1)
 	}
 
-	return util.Sum(fishCount[:])
+	// Simulating all the days at once is the same as applying the transition
+	// for every day, which only takes O(log days) matrix multiplications.
+	return matrix.Sum(matrix.Mul(matrix.Pow(lanternfishTransition, days), fishCount))
 }
 
 func Sol06(input string) (string, error) {
-	lines := util.ReadLines(input)
-
 	// fishes is a slice of integer each representing the number of days
 	// remaining until it creates a new fish.
-	var fishes []int
-	for _, s := range strings.Split(lines[0], ",") {
-		fishes = append(fishes, util.MustAtoi(s))
-	}
+	fishes := util.ReadCommaInts(input)
 
 	count1 := simulate(fishes, 80)
 	count2 := simulate(fishes, 256)
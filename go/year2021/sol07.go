@@ -2,32 +2,35 @@ package year2021
 
 import (
 	"fmt"
-	"math"
-	"strings"
 
+	"github.com/dhruvmanila/advent-of-code/go/pkg/search"
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
 
-func Sol07(input string) (string, error) {
-	lines := util.ReadLines(input)
-
-	var currentPos []int
-	for _, s := range strings.Split(lines[0], ",") {
-		currentPos = append(currentPos, util.MustAtoi(s))
+// fuelFunc returns a function which computes the total fuel required to align
+// all the crabs at a position, where cost is the fuel required to move a crab
+// by the given number of steps.
+//
+// As the cost for every crab is a convex function of the position, so is the
+// total fuel, which means the minimum can be found using ternary search.
+func fuelFunc(positions []int, cost func(steps int) int) func(p int) int {
+	return func(p int) int {
+		var total int
+		for _, hp := range positions {
+			total += cost(util.Abs(hp - p))
+		}
+		return total
 	}
+}
+
+func Sol07(input string) (string, error) {
+	currentPos := util.ReadCommaInts(input)
 
-	minFuel1, minFuel2 := math.MaxInt, math.MaxInt
 	minPos, maxPos := util.MinMax(currentPos)
-	for p := minPos; p <= maxPos; p++ {
-		var totalFuel1, totalFuel2 int
-		for _, hp := range currentPos {
-			steps := util.Abs(hp - p)
-			totalFuel1 += steps
-			totalFuel2 += util.SumN(steps)
-		}
-		minFuel1 = util.Min(minFuel1, totalFuel1)
-		minFuel2 = util.Min(minFuel2, totalFuel2)
-	}
+	_, minFuel1 := search.TernarySearch(minPos, maxPos, fuelFunc(currentPos, func(steps int) int {
+		return steps
+	}))
+	_, minFuel2 := search.TernarySearch(minPos, maxPos, fuelFunc(currentPos, util.SumN[int]))
 
 	return fmt.Sprintf("7.1: %d\n7.2: %d\n", minFuel1, minFuel2), nil
 }
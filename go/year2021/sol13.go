@@ -126,7 +126,10 @@ func Sol13(input string) (string, error) {
 		p.fold(instruction)
 	}
 
-	code, err := ocr.Convert6(p.String())
+	code, err := ocr.ConvertFunc(p.columns, p.rows, func(x, y int) bool {
+		_, exist := p.dots[point{x, y}]
+		return exist
+	})
 	if err != nil {
 		return "", err
 	}
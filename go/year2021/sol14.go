@@ -17,7 +17,7 @@ type memoKey struct {
 type polymer struct {
 	template string
 	rules    map[string]string
-	c        counter.Counter[string]
+	c        *counter.Counter[string]
 }
 
 func newPolymer(template string, rules map[string]string) *polymer {
@@ -33,10 +33,10 @@ func newPolymer(template string, rules map[string]string) *polymer {
 }
 
 func (p *polymer) process(steps int) {
-	var recursiveProcess func(string, int) counter.Counter[string]
-	memo := make(map[memoKey]counter.Counter[string])
+	var recursiveProcess func(string, int) *counter.Counter[string]
+	memo := make(map[memoKey]*counter.Counter[string])
 
-	recursiveProcess = func(pair string, steps int) counter.Counter[string] {
+	recursiveProcess = func(pair string, steps int) *counter.Counter[string] {
 		// Base case: No steps remaining.
 		if steps == 0 {
 			return counter.New[string]()
@@ -1,148 +1,67 @@
 package year2021
 
 import (
-	"container/heap"
 	"fmt"
 
-	"github.com/dhruvmanila/advent-of-code/go/pkg/queue"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/ansi"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/grid"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/set"
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
 
-// graph represents a weighted undirected graph.
-type graph struct {
-	// nodes is a matrix representing the node position (row, col) and the
-	// weight which is indexed at the position.
-	nodes [][]int
-
-	// rows and cols are the total number of rows and columns in the graph matrix.
-	rows int
-	cols int
-}
-
-func newGraph(nodes [][]int) *graph {
-	return &graph{
-		nodes: nodes,
-		rows:  len(nodes),
-		cols:  len(nodes[0]),
-	}
-}
-
-// at is used to get the weight of the node at a given position p.
-func (g *graph) at(p position) int {
-	return g.nodes[p.row][p.col]
-}
-
-// from is used to get the position of all the nodes in g that can be reached
-// directly from the node at a given position p.
-func (g *graph) from(p position) []position {
-	dirs := util.CardinalDirection(p.row, p.col, g.rows, g.cols)
-	edges := make([]position, len(dirs))
-	for _, d := range dirs {
-		edges = append(edges, position{d[0], d[1]})
-	}
-	return edges
-}
-
 // renderPath renders the given path on the grid by highlighting that position
-// using ANSII escape sequence.
-func (g *graph) renderPath(path []position) {
-	grid := make([][]string, len(g.nodes))
-	for y, row := range g.nodes {
-		grid[y] = make([]string, len(row))
-		for x, n := range row {
-			grid[y][x] = fmt.Sprint(n)
-		}
-	}
-	for _, p := range path {
-		grid[p.row][p.col] = fmt.Sprintf("\033[7m%s\033[0m", grid[p.row][p.col])
-	}
-	for _, row := range grid {
-		for _, c := range row {
-			fmt.Print(c)
+// in reverse video.
+func renderPath(g *grid.Grid[int], path []geom.Point2D[int]) {
+	onPath := set.New(path...)
+	fmt.Println(g.Format(func(p geom.Point2D[int], v int) string {
+		if onPath.Contains(p) {
+			return ansi.Style(fmt.Sprint(v), ansi.Reverse)
 		}
-		fmt.Println()
-	}
-	fmt.Println()
+		return fmt.Sprint(v)
+	}))
 }
 
-// lowestTotalRiskPath is used to calculate the lowest total risk taken by a path
-// from s to t node in a graph g.
+// lowestTotalRiskPath is used to calculate the lowest total risk taken by a
+// path from the top left to the bottom right corner of the grid g, where the
+// risk of a position is only counted when entering it.
 //
 // This is using the Dijkstra's algorithm for finding the shortest path.
-func lowestTotalRiskPath(s, t position, g *graph) int {
-	visited := make(map[position]bool)
-	risk := map[position]int{s: 0}
-	prev := make(map[position]position)
-
-	pq := queue.PriorityQueue{{Value: s, Priority: 0}}
-	for !pq.IsEmpty() {
-		item := heap.Pop(&pq).(*queue.Item)
-		p := item.Value.(position)
-		for _, to := range g.from(p) {
-			if visited[to] {
-				continue
-			}
-			joint := risk[p] + g.at(to)
-			if v, ok := risk[to]; !ok || joint < v {
-				heap.Push(&pq, &queue.Item{Value: to, Priority: joint})
-				risk[to] = joint
-				prev[to] = p
-			}
-		}
-		visited[p] = true
-	}
-
-	path := []position{t}
-	for p := prev[t]; p != s; p = prev[p] {
-		path = append([]position{p}, path...)
+func lowestTotalRiskPath(g *grid.Grid[int]) int {
+	from := geom.Point2D[int]{X: 0, Y: 0}
+	to := geom.Point2D[int]{X: g.Width() - 1, Y: g.Height() - 1}
+	risk, _, ok := g.ShortestPath(from, to, g.At, nil)
+	if !ok {
+		panic("no path found")
 	}
-	path = append([]position{s}, path...)
-	// g.renderPath(path)
-
-	return risk[t]
+	// renderPath(g, path)
+	return risk
 }
 
-func constructGraph(lines []string) *graph {
-	nodes := make([][]int, len(lines))
-	for y, line := range lines {
-		row := make([]int, len(line))
-		for x, d := range line {
-			row[x] = int(d - '0')
-		}
-		nodes[y] = row
-	}
-	return newGraph(nodes)
-}
-
-func constructGraphV2(lines []string) *graph {
-	nodes := make([][]int, len(lines)*5)
-	for i := range nodes {
-		nodes[i] = make([]int, len(lines[0])*5)
-	}
-	for y, line := range lines {
-		for x, d := range line {
-			n := int(d - '0')
-			for dy := 0; dy < 5; dy++ {
-				for dx := 0; dx < 5; dx++ {
-					row, col := dy*len(lines)+y, dx*len(line)+x
-					nodes[row][col] = (n+dx+dy-1)%9 + 1
-				}
+// constructGridV2 returns the full map which is the given tile repeated five
+// times in both the directions, where the risk increases by one for every
+// repetition to the right or downward, wrapping back to 1 after 9.
+func constructGridV2(tile *grid.Grid[int]) *grid.Grid[int] {
+	g := grid.New[int](tile.Width()*5, tile.Height()*5)
+	for p, n := range tile.All() {
+		for dy := 0; dy < 5; dy++ {
+			for dx := 0; dx < 5; dx++ {
+				g.Set(geom.Point2D[int]{
+					X: dx*tile.Width() + p.X,
+					Y: dy*tile.Height() + p.Y,
+				}, (n+dx+dy-1)%9+1)
 			}
 		}
 	}
-	return newGraph(nodes)
+	return g
 }
 
 func Sol15(input string) (string, error) {
-	lines := util.ReadLines(input)
-
-	from := position{0, 0}
-	to1 := position{len(lines[0]) - 1, len(lines) - 1}
-	to2 := position{len(lines[0])*5 - 1, len(lines)*5 - 1}
+	tile := grid.FromDigitLines(util.ReadLines(input))
 
 	return fmt.Sprintf(
 		"15.1: %d\n15.2: %d\n",
-		lowestTotalRiskPath(from, to1, constructGraph(lines)),
-		lowestTotalRiskPath(from, to2, constructGraphV2(lines)),
+		lowestTotalRiskPath(tile),
+		lowestTotalRiskPath(constructGridV2(tile)),
 	), nil
 }
@@ -3,7 +3,6 @@ package year2021
 import (
 	"encoding/hex"
 	"fmt"
-	"math"
 
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
@@ -53,32 +52,25 @@ func (p *operatorPacket) versionSum() int {
 	return v
 }
 
+// subValues returns the values of all the sub-packets in order.
+func (p *operatorPacket) subValues() []int {
+	values := make([]int, len(p.subPackets))
+	for i, sp := range p.subPackets {
+		values[i] = sp.evaluate()
+	}
+	return values
+}
+
 func (p *operatorPacket) evaluate() int {
 	switch p.typeId {
 	case 0:
-		sum := 0
-		for _, sp := range p.subPackets {
-			sum += sp.evaluate()
-		}
-		return sum
+		return util.Sum(p.subValues())
 	case 1:
-		product := 1
-		for _, sp := range p.subPackets {
-			product *= sp.evaluate()
-		}
-		return product
+		return util.Product(p.subValues())
 	case 2:
-		minimum := math.MaxInt
-		for _, sp := range p.subPackets {
-			minimum = util.Min(minimum, sp.evaluate())
-		}
-		return minimum
+		return util.MinOf(p.subValues()...)
 	case 3:
-		maximum := math.MinInt
-		for _, sp := range p.subPackets {
-			maximum = util.Max(maximum, sp.evaluate())
-		}
-		return maximum
+		return util.MaxOf(p.subValues()...)
 	case 5:
 		if p.subPackets[0].evaluate() > p.subPackets[1].evaluate() {
 			return 1
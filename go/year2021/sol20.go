@@ -4,125 +4,78 @@ import (
 	"fmt"
 
 	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
-	"github.com/dhruvmanila/advent-of-code/go/pkg/set"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/sparsegrid"
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
 
+// image is an infinite image where a light pixel is true. The default value
+// of the grid represents all the pixels in the infinite region.
 type image struct {
-	// pixels is a set of position containing all the light pixels.
-	pixels set.Set[position]
-
-	// bbox represents a bounding box containing the input image without the
-	// infinite region.
-	bbox *geom.BoundingBox2D
-
-	// inf represents all the pixels in the infinite region.
-	inf rune
+	*sparsegrid.Grid[bool]
 }
 
 func newImage(lines []string) *image {
-	pixels := set.New[position]()
-	var minx, maxx, miny, maxy int
-	for row, line := range lines {
-		for col, pixel := range line {
-			if pixel == '#' {
-				pixels.Add(position{row, col})
-				minx = util.Min(minx, col)
-				maxx = util.Max(maxx, col)
-				miny = util.Min(miny, row)
-				maxy = util.Max(maxy, row)
-			}
-		}
-	}
-	return &image{
-		pixels: pixels,
-		bbox:   geom.NewBoundingBox2D(minx, maxx, miny, maxy),
-		inf:    '.',
-	}
+	return &image{sparsegrid.Parse(lines, false, func(r rune) bool {
+		return r == '#'
+	})}
 }
 
 // apply is used to apply the algorithm on the image a number of times. Note
 // that this will update the image after applying the algorithm.
 func (i *image) apply(algorithm string, times int) {
-	if times == 0 {
-		return
-	}
-
-	// By default, all the infinite pixels are black, so when applying the
-	// algorithm we would be replacing the dark pixels with the pixel present
-	// in the zeroth position (binary 000000000). So, if the algorithm contains
-	// a light pixel and a dark pixel at the start and end, then the infinite
-	// region of the image will be flickering on and off.
-	if algorithm[0] == '#' && algorithm[len(algorithm)-1] == '.' {
-		if times%2 == 0 {
-			i.inf = '.'
-		} else {
-			i.inf = '#'
+	for ; times > 0; times-- {
+		// All the pixels in the infinite region are the same, so the index
+		// of their output pixel is either 0 (binary 000000000) or 511 (binary
+		// 111111111). So, if the algorithm contains a light pixel at the
+		// start and a dark pixel at the end, then the infinite region of the
+		// image will be flickering on and off.
+		infIdx := 0
+		if i.Default() {
+			infIdx = 511
 		}
-	}
 
-	// As the algorithm, needs to change all the pixels simultaneously, we will
-	// apply the changes in the new image from referencing the old image.
-	newImage := set.New[position]()
-	var minx, maxx, miny, maxy int
-
-	// Loop over all the pixels in the bounding box including 2 extra rows and
-	// columns from all 4 sides.
-	for row := i.bbox.MinY - 2; row <= i.bbox.MaxY+2; row++ {
-		for col := i.bbox.MinX - 2; col <= i.bbox.MaxX+2; col++ {
+		// As the algorithm, needs to change all the pixels simultaneously,
+		// the new image is formed by referencing the old image. Only the
+		// pixels within one pixel from the bounding box can be affected by
+		// the finite region of the image.
+		i.Grid = i.Step(1, algorithm[infIdx] == '#', func(p geom.Point2D[int]) bool {
 			idx := 0
-			bit := 8
-			for dy := -1; dy <= 1; dy++ {
-				for dx := -1; dx <= 1; dx++ {
-					y, x := row+dy, col+dx
-					// If the position is in the infinite region, then turn on
-					// the bit as per the infinite pixel.
-					if !i.bbox.Contains(x, y) {
-						if i.inf == '#' {
-							idx += 1 << bit
-						}
-						// Here, it's gurranteed that the position is within
-						// the image bounds.
-					} else if i.pixels.Contains(position{y, x}) {
-						idx += 1 << bit
-					}
-					bit--
+			for _, d := range imageWindow {
+				idx <<= 1
+				if i.Get(p.Add(d)) {
+					idx |= 1
 				}
 			}
-			if algorithm[idx] == '#' {
-				newImage.Add(position{row, col})
-				minx = util.Min(minx, col)
-				maxx = util.Max(maxx, col)
-				miny = util.Min(miny, row)
-				maxy = util.Max(maxy, row)
-			}
-		}
+			return algorithm[idx] == '#'
+		})
 	}
+}
 
-	// Update the image to reflect the new image formed after applying the
-	// algorithm once.
-	i.pixels = newImage
-	i.bbox.MinX = minx
-	i.bbox.MaxX = maxx
-	i.bbox.MinY = miny
-	i.bbox.MaxY = maxy
+// imageWindow contains the offsets of the 3x3 window of pixels around a pixel
+// in the order of the bits of the index into the algorithm, from the most
+// significant bit to the least.
+var imageWindow = [9]geom.Point2D[int]{
+	{X: -1, Y: -1}, {X: 0, Y: -1}, {X: 1, Y: -1},
+	{X: -1, Y: 0}, {X: 0, Y: 0}, {X: 1, Y: 0},
+	{X: -1, Y: 1}, {X: 0, Y: 1}, {X: 1, Y: 1},
+}
 
-	i.apply(algorithm, times-1)
+// lightPixels returns the number of light pixels in the image. It panics if
+// the infinite region is lit as there are infinitely many light pixels.
+func (i *image) lightPixels() int {
+	if i.Default() {
+		panic("infinite number of light pixels")
+	}
+	return i.Len()
 }
 
 func (i *image) String() string {
-	var s string
-	for row := i.bbox.MinY - 2; row <= i.bbox.MaxY+2; row++ {
-		for col := i.bbox.MinX - 2; col <= i.bbox.MaxX+2; col++ {
-			if i.pixels.Contains(position{row, col}) {
-				s += "#"
-			} else {
-				s += "."
-			}
+	return i.Format(2, func(_ geom.Point2D[int], light bool) string {
+		if light {
+			return "#"
 		}
-		s += "\n"
-	}
-	return s
+		return "."
+	})
 }
 
 func Sol20(input string) (string, error) {
@@ -131,12 +84,11 @@ func Sol20(input string) (string, error) {
 	// algorithm is the image enhancement algorithm string.
 	algorithm := lines[0]
 
-	// image is a set of position where all the light pixels exists.
 	image := newImage(lines[2:])
 
 	image.apply(algorithm, 2)
-	s := fmt.Sprintf("20.1: %d\n", image.pixels.Len())
+	s := fmt.Sprintf("20.1: %d\n", image.lightPixels())
 
 	image.apply(algorithm, 48)
-	return fmt.Sprintf("%s20.2: %d\n", s, image.pixels.Len()), nil
+	return fmt.Sprintf("%s20.2: %d\n", s, image.lightPixels()), nil
 }
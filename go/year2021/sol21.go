@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/dhruvmanila/advent-of-code/go/pkg/counter"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/memo"
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
 
@@ -53,7 +54,7 @@ func newPlayer(start int) *player {
 }
 
 func (p *player) move(steps int) {
-	p.pos = (p.pos+steps-1)%10 + 1
+	p.pos = util.Mod(p.pos+steps-1, 10) + 1
 	p.score += p.pos
 }
 
@@ -91,12 +92,9 @@ var quantumRolls = map[int]int{
 }
 
 func realGame(p1, p2 player) int {
-	memo := make(map[[2]player]counter.Counter[int])
-	var loop func(p, other player) counter.Counter[int]
-
 	// Here, p represents the currently playing player while other is waiting
 	// for its turn.
-	loop = func(p, other player) counter.Counter[int] {
+	loop := memo.Recursive2(func(loop func(p, other player) *counter.Counter[int], p, other player) *counter.Counter[int] {
 		// Base case: One of the player have score equal to or greater than 21.
 		switch {
 		case p.score >= 21:
@@ -105,11 +103,6 @@ func realGame(p1, p2 player) int {
 			return counter.New(other.id)
 		}
 
-		key := [2]player{p, other}
-		if value, ok := memo[key]; ok {
-			return value
-		}
-
 		c := counter.New[int]()
 		for steps, freq := range quantumRolls {
 			// We cannot update the original struct as we still have other
@@ -124,9 +117,8 @@ func realGame(p1, p2 player) int {
 			c.IncrementBy(other.id, rc.Get(other.id)*freq)
 		}
 
-		memo[key] = c
 		return c
-	}
+	})
 
 	wins := loop(p1, p2)
 	return util.Max(wins.Get(p1.id), wins.Get(p2.id))
@@ -1,16 +1,21 @@
 package year2021
 
 import (
-	"errors"
 	"fmt"
-	"regexp"
 
 	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/parse"
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
 
-var stepRegex = regexp.MustCompile(
-	`^(on|off) x=(-?\d+)\.\.(-?\d+),y=(-?\d+)\.\.(-?\d+),z=(-?\d+)\.\.(-?\d+)$`,
+// stepLine is a single line of the input describing a reboot step.
+type stepLine struct {
+	State                  string
+	X1, X2, Y1, Y2, Z1, Z2 int
+}
+
+var stepBinder = parse.MustBinder[stepLine](
+	`^(?P<state>on|off) x=(?P<x1>-?\d+)\.\.(?P<x2>-?\d+),y=(?P<y1>-?\d+)\.\.(?P<y2>-?\d+),z=(?P<z1>-?\d+)\.\.(?P<z2>-?\d+)$`,
 )
 
 // rebootStep contains information about a single reboot step.
@@ -158,20 +163,13 @@ func reboot(rebootSteps []*rebootStep) int {
 func parseSteps(lines []string) ([]*rebootStep, error) {
 	steps := make([]*rebootStep, len(lines))
 	for i, line := range lines {
-		matches := stepRegex.FindStringSubmatch(line)
-		if len(matches) != 8 {
-			return nil, errors.New("invalid reboot step")
+		l, err := stepBinder.Bind(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i, err)
 		}
 		steps[i] = &rebootStep{
-			state: matches[1] == "on",
-			cuboid: geom.NewBoundingBox3D(
-				util.MustAtoi(matches[2]),
-				util.MustAtoi(matches[3]),
-				util.MustAtoi(matches[4]),
-				util.MustAtoi(matches[5]),
-				util.MustAtoi(matches[6]),
-				util.MustAtoi(matches[7]),
-			),
+			state:  l.State == "on",
+			cuboid: geom.NewBoundingBox3D(l.X1, l.X2, l.Y1, l.Y2, l.Z1, l.Z2),
 		}
 	}
 	return steps, nil
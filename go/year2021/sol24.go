@@ -4,74 +4,57 @@ import (
 	"fmt"
 	"math"
 	"sort"
-	"strconv"
-	"strings"
 
 	"github.com/dhruvmanila/advent-of-code/go/pkg/stack"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/vm"
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
 
-type alu struct {
-	instructions []string
-	vars         map[string]int
+// binaryOp returns an ALU operation which stores the result of fn applied to
+// the values of both the arguments in the register given as the first one.
+func binaryOp(fn func(a, b int) int) vm.Op {
+	return vm.Op{Arity: 2, Exec: func(m *vm.Machine, args []string) error {
+		m.Registers[args[0]] = fn(m.Value(args[0]), m.Value(args[1]))
+		return nil
+	}}
 }
 
-func newAlu(instructions []string) *alu {
-	return &alu{
-		instructions: instructions,
-		vars: map[string]int{
-			"w": 0,
-			"x": 0,
-			"y": 0,
-			"z": 0,
-		},
-	}
+// aluOps is the instruction set of the ALU.
+var aluOps = vm.InstructionSet{
+	"inp": {Arity: 1, Exec: func(m *vm.Machine, args []string) error {
+		v, err := m.Read()
+		m.Registers[args[0]] = v
+		return err
+	}},
+	"add": binaryOp(func(a, b int) int { return a + b }),
+	"mul": binaryOp(func(a, b int) int { return a * b }),
+	"div": binaryOp(func(a, b int) int { return a / b }),
+	"mod": binaryOp(func(a, b int) int { return a % b }),
+	"eql": binaryOp(func(a, b int) int {
+		if a == b {
+			return 1
+		}
+		return 0
+	}),
 }
 
-func (a *alu) run(input int) {
-	digits := util.Digits(input)
-	for _, instruction := range a.instructions {
-		fields := strings.Fields(instruction)
-		opcode, result := fields[0], fields[1]
-
-		var num int
-		if len(fields) == 3 {
-			i, err := strconv.Atoi(fields[2])
-			if err == nil {
-				num = i
-			} else {
-				num = a.vars[fields[2]]
-			}
-		}
-
-		switch opcode {
-		case "inp":
-			a.vars[result] = <-digits
-		case "add":
-			a.vars[result] += num
-		case "mul":
-			a.vars[result] *= num
-		case "div":
-			a.vars[result] /= num
-		case "mod":
-			a.vars[result] %= num
-		case "eql":
-			if a.vars[result] == num {
-				a.vars[result] = 1
-			} else {
-				a.vars[result] = 0
-			}
-		default:
-			panic("invalid opcode: " + opcode)
+// runAlu runs the MONAD program on the ALU with the digits of the given model
+// number as the input and returns the value of the z variable.
+func runAlu(program []vm.Instruction, modelNum int) (int, error) {
+	m := vm.New(program, aluOps)
+	digits := util.DigitsSlice(modelNum)
+	m.Input = func() (int, bool) {
+		if len(digits) == 0 {
+			return 0, false
 		}
+		d := digits[0]
+		digits = digits[1:]
+		return d, true
 	}
-}
-
-// reset will reset all the variables back to 0.
-func (a *alu) reset() {
-	for v := range a.vars {
-		a.vars[v] = 0
+	if err := m.Run(); err != nil {
+		return 0, err
 	}
+	return m.Registers["z"], nil
 }
 
 // z is used as a stack storing a bunch of small numbers at once by treating
@@ -277,16 +260,15 @@ func Sol24(input string) (string, error) {
 	smallestModelNum := formNumber(minimizedDigits)
 
 	// Let's fire up the ALU to verify our solution.
-	alu := newAlu(lines)
-	alu.run(largestModelNum)
-	if alu.vars["z"] != 0 {
-		return "", fmt.Errorf("z is not 0 for largest model number: %d", largestModelNum)
-	}
-
-	alu.reset()
-	alu.run(smallestModelNum)
-	if alu.vars["z"] != 0 {
-		return "", fmt.Errorf("z is not 0 for smallest model number: %d", smallestModelNum)
+	program := vm.Parse(lines)
+	for _, modelNum := range []int{largestModelNum, smallestModelNum} {
+		z, err := runAlu(program, modelNum)
+		if err != nil {
+			return "", err
+		}
+		if z != 0 {
+			return "", fmt.Errorf("z is not 0 for model number: %d", modelNum)
+		}
 	}
 
 	return fmt.Sprintf("24.1: %d\n24.2: %d\n", largestModelNum, smallestModelNum), nil
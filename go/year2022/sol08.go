@@ -119,11 +119,7 @@ func (f *forest) VisibleCount() int {
 // the individual scores has already been computed. Use the `VisibleCount`
 // method to compute the scores.
 func (f *forest) MaxScore() int {
-	maxScore := 0
-	for _, score := range f.scenicScore.Data {
-		maxScore = util.Max(maxScore, score)
-	}
-	return maxScore
+	return util.MaxOf(f.scenicScore.Data...)
 }
 
 func (f *forest) String() string {
@@ -21,14 +21,12 @@ type motion struct {
 }
 
 func parseMotions(lines []string) []*motion {
-	m := make([]*motion, 0, len(lines))
-	for _, line := range lines {
-		m = append(m, &motion{
+	return util.Map(lines, func(line string) *motion {
+		return &motion{
 			direction: line[0],
 			steps:     util.MustAtoi(line[2:]),
-		})
-	}
-	return m
+		}
+	})
 }
 
 // isTouching returns true if tail is adjacent to the head in either of
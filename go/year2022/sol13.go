@@ -2,6 +2,7 @@ package year2022
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -18,13 +19,13 @@ const (
 // packetTokenizer is used to generate the tokens for the packet.
 // Valid tokens are: "[", "]", "," and any digits from 0-9.
 type packetTokenizer struct {
-	packet *iterator.Iterator[string]
+	packet *iterator.Peekable[string]
 	stack  *stack.Stack[string]
 }
 
 func newPacketTokenizer(packet string) *packetTokenizer {
 	return &packetTokenizer{
-		packet: iterator.New(strings.Split(packet, "")),
+		packet: iterator.NewPeekable(slices.Values(strings.Split(packet, ""))),
 		stack:  stack.New[string](),
 	}
 }
@@ -42,21 +43,11 @@ func (t *packetTokenizer) next() string {
 		case "]", "[":
 			return token
 		case "0", "1", "2", "3", "4", "5", "6", "7", "8", "9":
-		NextLoop:
-			// Collect the next digits to form the entire number.
-			for t.packet.Next() {
-				switch nextToken := t.packet.Value(); nextToken {
-				case ",":
-					break NextLoop
-				case "]":
-					// Now that the number is complete and we've found a
-					// token, push it on to the stack for it to be returned
-					// on the next call.
-					t.stack.Push(nextToken)
-					break NextLoop
-				case "0", "1", "2", "3", "4", "5", "6", "7", "8", "9":
-					token += nextToken
-				}
+			// Collect the next digits to form the entire number, leaving the
+			// token after it to be returned on the next call.
+			for next, ok := t.packet.Peek(); ok && next >= "0" && next <= "9"; next, ok = t.packet.Peek() {
+				t.packet.Next()
+				token += next
 			}
 			return token
 		default:
@@ -79,6 +70,8 @@ func (t *packetTokenizer) pushback(number string) {
 // the rules stated in the problem statement.
 func Less(lhs string, rhs string) bool {
 	p1, p2 := newPacketTokenizer(lhs), newPacketTokenizer(rhs)
+	defer p1.packet.Stop()
+	defer p2.packet.Stop()
 
 	for t1, t2 := p1.next(), p2.next(); t1 != "" && t2 != ""; t1, t2 = p1.next(), p2.next() {
 		switch {
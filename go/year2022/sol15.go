@@ -2,10 +2,10 @@ package year2022
 
 import (
 	"fmt"
-	"math"
 	"strings"
 
 	"github.com/dhruvmanila/advent-of-code/go/pkg/geom"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/interval"
 	"github.com/dhruvmanila/advent-of-code/go/pkg/set"
 	"github.com/dhruvmanila/advent-of-code/go/util"
 )
@@ -48,23 +48,23 @@ func (s *sensor) String() string {
 }
 
 // coveredCountAt returns the number of points at y which are covered by
-// the given sensors.
+// the given sensors and cannot contain a beacon.
 //
-// The solution basically involves using the ranges of x to our advantage
-// to get the minx and maxx or the range between which the coverage exists.
-// This assumes that there are no disjoint ranges to create holes between
-// the x range.
+// Every sensor which reaches y covers a contiguous range of x at that row.
+// The union of these ranges gives the covered points, excluding the beacons
+// which already exist in that row.
 func coveredCountAt(sensors []*sensor, y int) int {
-	minx, maxx := math.MaxInt, math.MinInt
+	covered := interval.NewSet[int]()
 	for _, s := range sensors {
 		count := s.distance - util.Abs(s.pos.Y-y)
-		minx = util.Min(s.pos.X-count, minx)
-		maxx = util.Max(s.pos.X+count, maxx)
+		if count >= 0 {
+			covered.Insert(interval.New(s.pos.X-count, s.pos.X+count))
+		}
 	}
-	count := maxx - minx + 1
+	count := covered.TotalLength()
 	seen := set.New[int]()
 	for _, s := range sensors {
-		if s.beacon.Y == y && !seen.Contains(s.beacon.X) {
+		if s.beacon.Y == y && !seen.Contains(s.beacon.X) && covered.Contains(s.beacon.X) {
 			seen.Add(s.beacon.X)
 			count--
 		}
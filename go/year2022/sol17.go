@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/dhruvmanila/advent-of-code/go/pkg/cycle"
 	"github.com/dhruvmanila/advent-of-code/go/pkg/iterator"
+	"github.com/dhruvmanila/advent-of-code/go/pkg/shape"
 )
 
 const (
-	oneTrillion = 1_000_000_000_000
-	rightEdge   = 1      // 0b0000001
-	leftEdge    = 1 << 6 // 0b1000000
+	oneTrillion  = 1_000_000_000_000
+	chamberWidth = 7
+	rightEdge    = 1      // 0b0000001
+	leftEdge     = 1 << 6 // 0b1000000
 )
 
 type rockShape []int
@@ -24,36 +27,27 @@ func (r rockShape) String() string {
 	return strings.Join(lines, "\n")
 }
 
-var rockShapes = []rockShape{
-	// line
-	{
-		0b0011110,
-	},
-	// plus
-	{
-		0b0001000,
-		0b0011100,
-		0b0001000,
-	},
-	// flipped L
-	{
-		0b0000100,
-		0b0000100,
-		0b0011100,
-	},
-	// column
-	{
-		0b0010000,
-		0b0010000,
-		0b0010000,
-		0b0010000,
-	},
-	// square
-	{
-		0b0011000,
-		0b0011000,
-	},
-}
+// rockShapes are the shapes of the rocks in the order they fall, where each
+// line of a shape is a bitmask of the chamber row such that its left edge is
+// two units away from the left wall.
+var rockShapes = func() []rockShape {
+	pieces := [][]string{
+		{"####"},              // line
+		{".#.", "###", ".#."}, // plus
+		{"..#", "..#", "###"}, // flipped L
+		{"#", "#", "#", "#"},  // column
+		{"##", "##"},          // square
+	}
+	shapes := make([]rockShape, len(pieces))
+	for i, piece := range pieces {
+		rows, ok := shape.Parse(piece, '#').Rows(chamberWidth, 2)
+		if !ok {
+			panic("rock does not fit in the chamber: " + strings.Join(piece, "\n"))
+		}
+		shapes[i] = rows
+	}
+	return shapes
+}()
 
 type verticalChamber struct {
 	rockPile []int
@@ -177,42 +171,35 @@ func renderDigits(digits string) string {
 	return s
 }
 
-type cacheEntry struct {
-	step   int
-	height int
+// surfaceDepth is the number of rows from the top of the rock pile which are
+// considered to identify the state of the chamber. A falling rock can only
+// reach a few rows below the top, so the rows below it never matter.
+const surfaceDepth = 32
+
+// chamberState identifies the state of the chamber for the cycle detection.
+// It consists of the index of the current rock and jet along with the top
+// rows of the rock pile.
+type chamberState struct {
+	rockIdx, jetIdx int
+	surface         [surfaceDepth]int
 }
 
-func Sol17(input string) (string, error) {
-	jets := bytes.TrimRight([]byte(input), "\n")
-
-	var height1, height2 int
-	cache := make(map[[2]int]cacheEntry)
+// State returns the current state of the chamber.
+func (v *verticalChamber) State() chamberState {
+	state := chamberState{rockIdx: v.RockIdx(), jetIdx: v.JetIdx()}
+	copy(state.surface[:], v.rockPile)
+	return state
+}
 
+// heightAfter returns the height of the rock pile after the given number of
+// rocks have been dropped into a new chamber with the given jets.
+func heightAfter(jets []byte, rocks int) int {
 	room := NewVerticalChamber(jets)
-	for i := 0; i < oneTrillion; i++ {
-		if i == 2022 {
-			height1 = room.Height()
-		}
-		key := [2]int{room.RockIdx(), room.JetIdx()}
-		if v, ok := cache[key]; ok {
-			remaining, lastSeen := oneTrillion-i, i-v.step
-			if remaining%lastSeen == 0 {
-				height2 = room.Height() + remaining/lastSeen*(room.Height()-v.height)
-				// Let's compute the first part height if the cycle was
-				// seen before the 2022nd rock. This is the case for my
-				// test input.
-				if i < 2022 {
-					for ; i < 2022; i++ {
-						room.DropRock()
-					}
-					height1 = room.Height()
-				}
-				break
-			}
-		}
-		cache[key] = cacheEntry{step: i, height: room.Height()}
-		room.DropRock()
-	}
+	return cycle.Extrapolate(rocks, room.State, room.Height, room.DropRock)
+}
+
+func Sol17(input string) (string, error) {
+	jets := bytes.TrimRight([]byte(input), "\n")
 
-	return fmt.Sprintf("17.1: %d\n17.2: %d\n", height1, height2), nil
+	return fmt.Sprintf("17.1: %d\n17.2: %d\n", heightAfter(jets, 2022), heightAfter(jets, oneTrillion)), nil
 }